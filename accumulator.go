@@ -0,0 +1,42 @@
+package julian
+
+import (
+	"math"
+	"time"
+)
+
+// An Accumulator sums many small intervals onto a julian date using Neumaier
+// compensated summation, so the rounding error does not grow with the number
+// of additions the way repeated jd.AddDays does.
+//
+// The zero value is an accumulator starting at JD 0.
+type Accumulator struct {
+	sum  float64
+	comp float64 // running compensation for lost low-order bits
+}
+
+// NewAccumulator returns an accumulator starting at the julian date start.
+func NewAccumulator(start Date) *Accumulator {
+	return &Accumulator{sum: float64(start)}
+}
+
+// AddDays adds the given number of days to the accumulated date.
+func (a *Accumulator) AddDays(days float64) {
+	t := a.sum + days
+	if math.Abs(a.sum) >= math.Abs(days) {
+		a.comp += (a.sum - t) + days
+	} else {
+		a.comp += (days - t) + a.sum
+	}
+	a.sum = t
+}
+
+// Add adds the duration d to the accumulated date.
+func (a *Accumulator) Add(d time.Duration) {
+	a.AddDays(float64(d) / day_nanoseconds)
+}
+
+// Date returns the accumulated julian date.
+func (a *Accumulator) Date() Date {
+	return Date(a.sum + a.comp)
+}
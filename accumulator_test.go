@@ -0,0 +1,32 @@
+package julian
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestAccumulator(t *testing.T) {
+	const n = 1_000_000
+	start := Date(2_451_545)
+	step := 37 * time.Millisecond
+
+	acc := NewAccumulator(start)
+	for range n {
+		acc.Add(step)
+	}
+	want := start + Date(float64(n*step)/day_nanoseconds)
+	if got := acc.Date(); math.Abs(float64(got-want)) > 1e-9 {
+		t.Errorf("Accumulator.Date() = %.10f, want %.10f", got, want)
+	}
+}
+
+func TestAccumulator_AddDays(t *testing.T) {
+	var acc Accumulator
+	acc.AddDays(1e16)
+	acc.AddDays(1)
+	acc.AddDays(-1e16)
+	if got := acc.Date(); got != 1 {
+		t.Errorf("Accumulator.Date() = %v, want 1", got)
+	}
+}
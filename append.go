@@ -0,0 +1,46 @@
+package julian
+
+import (
+	"slices"
+	"time"
+)
+
+// AppendDates appends the julian dates of the times ts to dst and returns the
+// extended slice.
+func AppendDates(dst []Date, ts []time.Time) []Date {
+	dst = slices.Grow(dst, len(ts))
+	for i := range ts {
+		dst = append(dst, Time(ts[i]))
+	}
+	return dst
+}
+
+// AppendTimes appends the julian dates jds as times in the local time zone,
+// as returned by Gregorian, to dst and returns the extended slice.
+func AppendTimes(dst []time.Time, jds []Date) []time.Time {
+	dst = slices.Grow(dst, len(jds))
+	for _, jd := range jds {
+		dst = append(dst, jd.Gregorian())
+	}
+	return dst
+}
+
+// AppendFloat64s appends the julian dates jds as float64 values to dst and
+// returns the extended slice.
+func AppendFloat64s(dst []float64, jds []Date) []float64 {
+	dst = slices.Grow(dst, len(jds))
+	for _, jd := range jds {
+		dst = append(dst, float64(jd))
+	}
+	return dst
+}
+
+// AppendFromFloat64s appends the float64 values fs as julian dates to dst and
+// returns the extended slice.
+func AppendFromFloat64s(dst []Date, fs []float64) []Date {
+	dst = slices.Grow(dst, len(fs))
+	for _, f := range fs {
+		dst = append(dst, Date(f))
+	}
+	return dst
+}
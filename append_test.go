@@ -0,0 +1,47 @@
+package julian
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestAppendDates(t *testing.T) {
+	ts := []time.Time{
+		time.Date(2000, time.January, 1, 12, 0, 0, 0, time.UTC),
+		time.Date(1970, time.January, 1, 0, 0, 0, 0, time.UTC),
+	}
+	got := AppendDates([]Date{1}, ts)
+	want := []Date{1, 2_451_545, julian_unix}
+	if !slices.Equal(got, want) {
+		t.Errorf("AppendDates() = %v, want %v", got, want)
+	}
+	times := AppendTimes(nil, got[1:])
+	if len(times) != len(ts) {
+		t.Fatalf("AppendTimes() = %v, want %v", times, ts)
+	}
+	for i := range ts {
+		if !times[i].Equal(ts[i]) {
+			t.Errorf("AppendTimes()[%d] = %v, want %v", i, times[i], ts[i])
+		}
+	}
+}
+
+func TestAppendFloat64s(t *testing.T) {
+	fs := AppendFloat64s([]float64{0}, []Date{2_451_545, -1.5})
+	if want := []float64{0, 2_451_545, -1.5}; !slices.Equal(fs, want) {
+		t.Errorf("AppendFloat64s() = %v, want %v", fs, want)
+	}
+	jds := AppendFromFloat64s(nil, fs)
+	if want := []Date{0, 2_451_545, -1.5}; !slices.Equal(jds, want) {
+		t.Errorf("AppendFromFloat64s() = %v, want %v", jds, want)
+	}
+}
+
+func TestAppendDates_allocs(t *testing.T) {
+	ts := make([]time.Time, 1000)
+	dst := make([]Date, 0, len(ts))
+	if n := testing.AllocsPerRun(10, func() { AppendDates(dst[:0], ts) }); n != 0 {
+		t.Errorf("AppendDates() allocated %v times, want 0", n)
+	}
+}
@@ -0,0 +1,41 @@
+package julian
+
+import (
+	"math"
+	"math/big"
+	"time"
+)
+
+// DefaultBigPrec is the mantissa precision, in bits, of the values returned
+// by TimeBig. It resolves any julian date of the next billion years to well
+// under a picosecond.
+const DefaultBigPrec = 128
+
+// TimeBig returns the julian date of the time t as a big.Float with
+// DefaultBigPrec bits of precision.
+func TimeBig(t time.Time) *big.Float {
+	return TimeBigPrec(t, DefaultBigPrec)
+}
+
+// TimeBigPrec returns the julian date of the time t as a big.Float with prec
+// bits of precision. If prec is 0, DefaultBigPrec is used.
+func TimeBigPrec(t time.Time, prec uint) *big.Float {
+	if prec == 0 {
+		prec = DefaultBigPrec
+	}
+	ns := new(big.Int).Mul(big.NewInt(t.Unix()), big.NewInt(1e9))
+	ns.Add(ns, big.NewInt(int64(t.Nanosecond())))
+	f := new(big.Float).SetPrec(prec).SetInt(ns)
+	f.Quo(f, new(big.Float).SetPrec(prec).SetInt64(day_nanoseconds))
+	return f.Add(f, big.NewFloat(julian_unix))
+}
+
+// FromBig returns the julian date nearest to f. It returns ErrRange if f is
+// infinite or too large in magnitude for a Date.
+func FromBig(f *big.Float) (Date, error) {
+	v, _ := f.Float64()
+	if math.IsInf(v, 0) {
+		return 0, ErrRange
+	}
+	return Date(v), nil
+}
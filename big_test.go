@@ -0,0 +1,77 @@
+package julian
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+	"time"
+)
+
+func TestTimeBig(t *testing.T) {
+	tests := []struct {
+		name string
+		t    time.Time
+		want string
+	}{
+		{"J2000", time.Date(2000, time.January, 1, 12, 0, 0, 0, time.UTC), "2451545.000000000000000"},
+		{"nanosecond", time.Date(2000, time.January, 1, 12, 0, 0, 1, time.UTC), "2451545.000000000000012"},
+		{"year 1000", time.Date(1000, time.January, 1, 18, 0, 0, 0, time.UTC), "2086303.250000000000000"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := TimeBig(tt.t)
+			if got.Prec() != DefaultBigPrec {
+				t.Errorf("TimeBig().Prec() = %d, want %d", got.Prec(), DefaultBigPrec)
+			}
+			if s := got.Text('f', 15); s != tt.want {
+				t.Errorf("TimeBig() = %s, want %s", s, tt.want)
+			}
+		})
+	}
+}
+
+func TestTimeBigPrec(t *testing.T) {
+	tm := time.Date(2010, time.February, 14, 5, 21, 0, 0, time.UTC)
+	if got := TimeBigPrec(tm, 256).Prec(); got != 256 {
+		t.Errorf("TimeBigPrec(256).Prec() = %d", got)
+	}
+	if got := TimeBigPrec(tm, 0).Prec(); got != DefaultBigPrec {
+		t.Errorf("TimeBigPrec(0).Prec() = %d, want %d", got, DefaultBigPrec)
+	}
+	got, err := FromBig(TimeBigPrec(tm, 64))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := Time(tm); !equalJulian(got, want) {
+		t.Errorf("FromBig(TimeBigPrec()) = %v, want %v", got, want)
+	}
+}
+
+func TestFromBig(t *testing.T) {
+	tests := []struct {
+		name    string
+		f       *big.Float
+		want    Date
+		wantErr bool
+	}{
+		{"J2000", big.NewFloat(2_451_545), Date(2_451_545), false},
+		{"infinite", new(big.Float).SetInf(false), 0, true},
+		{"too large", new(big.Float).SetMantExp(big.NewFloat(1), 2000), 0, true},
+		{"max", big.NewFloat(math.MaxFloat64), Date(math.MaxFloat64), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FromBig(tt.f)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("FromBig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrRange) {
+				t.Errorf("FromBig() error = %v, want ErrRange", err)
+			}
+			if got != tt.want {
+				t.Errorf("FromBig() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
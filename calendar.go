@@ -0,0 +1,369 @@
+package julian
+
+import (
+	"math"
+	"strconv"
+	"time"
+)
+
+// A CalendarSystem selects the rules used to break a julian date into
+// calendar fields.
+type CalendarSystem int
+
+const (
+	ProlepticGregorian CalendarSystem = iota // Gregorian rules for all dates
+	ProlepticJulian                          // Julian rules for all dates
+)
+
+// String returns the name of the calendar system.
+func (cs CalendarSystem) String() string {
+	switch cs {
+	case ProlepticGregorian:
+		return "proleptic Gregorian"
+	case ProlepticJulian:
+		return "proleptic Julian"
+	}
+	return "CalendarSystem(" + strconv.Itoa(int(cs)) + ")"
+}
+
+// Date returns the year, month, and day of the UTC calendar day containing
+// jd in the proleptic Gregorian calendar. Years are numbered astronomically,
+// so the year 1 BC is 0.
+//
+// Unlike jd.Gregorian().Date(), the result is valid for the full julian day
+// range.
+func (jd Date) Date() (year int, month time.Month, day int) {
+	days, _ := jd.civil()
+	return civilFromDays(days)
+}
+
+// DateIn is like Date but uses the rules of the given calendar system.
+func (jd Date) DateIn(cs CalendarSystem) (year int, month time.Month, day int) {
+	days, _ := jd.civil()
+	if cs == ProlepticJulian {
+		return julianFromDays(days)
+	}
+	return civilFromDays(days)
+}
+
+// IsLeapYear reports whether the UTC calendar day containing jd falls in a
+// leap year of the proleptic Gregorian calendar.
+func (jd Date) IsLeapYear() bool {
+	return jd.IsLeapYearIn(ProlepticGregorian)
+}
+
+// IsLeapYearIn is like IsLeapYear but uses the rules of the given calendar
+// system.
+func (jd Date) IsLeapYearIn(cs CalendarSystem) bool {
+	y, _, _ := jd.DateIn(cs)
+	return isLeap(y, cs)
+}
+
+// DaysInMonth returns the number of days in the proleptic Gregorian month
+// containing the UTC calendar day of jd.
+func (jd Date) DaysInMonth() int {
+	return jd.DaysInMonthIn(ProlepticGregorian)
+}
+
+// DaysInMonthIn is like DaysInMonth but uses the rules of the given calendar
+// system.
+func (jd Date) DaysInMonthIn(cs CalendarSystem) int {
+	y, m, _ := jd.DateIn(cs)
+	return daysIn(m, y, cs)
+}
+
+// YearDay returns the day of the year of the UTC calendar day containing jd,
+// in the range [1,365] for non-leap years, and [1,366] in leap years.
+func (jd Date) YearDay() int {
+	days, _ := jd.civil()
+	y, _, _ := civilFromDays(days)
+	return int(days-daysFromCivil(y, time.January, 1)) + 1
+}
+
+// Ordinal returns the ordinal date of the UTC calendar day containing jd in
+// the proleptic Gregorian calendar: the year and the day of the year, in
+// the range [1,366].
+func (jd Date) Ordinal() (year, doy int) {
+	days, _ := jd.civil()
+	year, _, _ = civilFromDays(days)
+	return year, int(days-daysFromCivil(year, time.January, 1)) + 1
+}
+
+// FromOrdinal returns the julian date of the ordinal date year and doy, the
+// day of the year starting at 1 for January 1, plus the fraction frac of the
+// day since midnight UTC. Days outside the year are counted on from
+// January 1.
+func FromOrdinal(year, doy int, frac float64) Date {
+	return fromCivil(daysFromCivil(year, time.January, 1)+int64(doy-1), 0) + Date(frac)
+}
+
+const (
+	julian_period       = 7980  // years, the product of the 28-, 19- and 15-year cycles
+	julian_period_start = -4712 // the first year of the first period, 4713 BC
+)
+
+// JulianPeriod returns the position in Scaliger's Julian Period of the
+// proleptic Julian calendar year containing the UTC calendar day of jd. The
+// period is numbered from 1 for the period beginning in 4713 BC, which
+// contains all dates from JD 0 to the year AD 3267, and yearInPeriod
+// is in the range [1,7980].
+func (jd Date) JulianPeriod() (period int, yearInPeriod int) {
+	y, _, _ := jd.DateIn(ProlepticJulian)
+	n := int64(y - julian_period_start)
+	return int(floorDiv(n, julian_period)) + 1, int(floorMod(n, julian_period)) + 1
+}
+
+// ISOWeek returns the ISO 8601 year and week number in which the UTC calendar
+// day containing jd occurs. Week ranges from 1 to 53. Jan 01 to Jan 03 of year
+// n might belong to week 52 or 53 of year n-1, and Dec 29 to Dec 31 might
+// belong to week 1 of year n+1.
+func (jd Date) ISOWeek() (year, week int) {
+	days, _ := jd.civil()
+	// weeks run Monday to Sunday and belong to the year of their Thursday
+	thursday := days - floorMod(days+3, 7) + 3
+	year, _, _ = civilFromDays(thursday)
+	return year, int((thursday-daysFromCivil(year, time.January, 1))/7) + 1
+}
+
+// ISOWeekDate returns the ISO 8601 week date of the UTC calendar day
+// containing jd: the year and week as ISOWeek returns them, and the weekday
+// from 1 (Monday) to 7 (Sunday).
+func (jd Date) ISOWeekDate() (year, week, weekday int) {
+	days, _ := jd.civil()
+	year, week = jd.ISOWeek()
+	return year, week, int(floorMod(days+3, 7)) + 1
+}
+
+// FromISOWeekDate returns the julian date of midnight UTC at the start of
+// the given ISO 8601 week date, with weekday from 1 (Monday) to 7 (Sunday).
+// Weeks and weekdays outside their usual ranges are counted on from the
+// first week of the year.
+func FromISOWeekDate(year, week, weekday int) Date {
+	// week 1 is the week containing January 4
+	jan4 := daysFromCivil(year, time.January, 4)
+	monday := jan4 - floorMod(jan4+3, 7)
+	return fromCivil(monday+7*int64(week-1)+int64(weekday-1), 0)
+}
+
+// Quarter returns the calendar quarter, in the range [1,4], of the UTC
+// calendar day containing jd.
+func (jd Date) Quarter() int {
+	_, m, _ := jd.Date()
+	return (int(m)-1)/3 + 1
+}
+
+// Half returns the half of the year, 1 or 2, of the UTC calendar day
+// containing jd.
+func (jd Date) Half() int {
+	_, m, _ := jd.Date()
+	return (int(m)-1)/6 + 1
+}
+
+// FiscalQuarter returns the fiscal year and quarter of the UTC calendar day
+// containing jd for a fiscal year that begins on the first day of the start
+// month. The fiscal year is numbered by the calendar year in which it ends,
+// so with an October start, October 1, 2023 is the first quarter of fiscal
+// year 2024. A start month outside [January, December] is normalized.
+func (jd Date) FiscalQuarter(start time.Month) (year, quarter int) {
+	y, m, _ := jd.Date()
+	s := int(floorMod(int64(start)-1, 12))
+	offset := (int(m) - 1 - s + 12) % 12
+	year = y
+	if s != 0 && int(m)-1 >= s {
+		year++
+	}
+	return year, offset/3 + 1
+}
+
+// Clock returns the hour, minute, and second within the UTC calendar day
+// containing jd.
+func (jd Date) Clock() (hour, min, sec int) {
+	_, nsec := jd.civil()
+	s := int(nsec / 1e9)
+	return s / 3600, s / 60 % 60, s % 60
+}
+
+// WithClock returns the julian date on the UTC calendar day containing jd at
+// the given time of day. The values may be outside their usual ranges and
+// will be normalized, so WithClock(24, 0, 0, 0) is midnight of the next day.
+func (jd Date) WithClock(hour, min, sec, nsec int) Date {
+	days, _ := jd.civil()
+	s := (int64(hour)*60+int64(min))*60 + int64(sec)
+	return fromCivil(days, s*1e9+int64(nsec))
+}
+
+// WithFraction returns the julian date on the julian day containing jd, which
+// begins at noon UTC, with the day fraction replaced by frac.
+func (jd Date) WithFraction(frac float64) Date {
+	day, _ := jd.Split()
+	return FromDayNumber(day, frac)
+}
+
+// Nanosecond returns the nanosecond offset within the second specified by jd,
+// in the range [0, 999999999].
+func (jd Date) Nanosecond() int {
+	_, nsec := jd.civil()
+	return int(nsec % 1e9)
+}
+
+// Weekday returns the day of the week of the UTC calendar day containing jd.
+// It is computed from the julian day number, so it is valid for any date.
+func (jd Date) Weekday() time.Weekday {
+	return time.Weekday(floorMod(jd.JDN()+1, 7))
+}
+
+// floorMod returns x modulo y with the sign of y.
+func floorMod(x, y int64) int64 {
+	m := x % y
+	if m != 0 && (m < 0) != (y < 0) {
+		m += y
+	}
+	return m
+}
+
+// civilFromDays returns the proleptic Gregorian calendar date that is the
+// given number of days after January 1, 1970.
+func civilFromDays(days int64) (year int, month time.Month, day int) {
+	z := days + 719468 // days since 3/1/0000
+	era := floorDiv(z, 146097)
+	doe := z - era*146097                                  // [0, 146096]
+	yoe := (doe - doe/1460 + doe/36524 - doe/146096) / 365 // [0, 399]
+	doy := doe - (365*yoe + yoe/4 - yoe/100)               // [0, 365]
+	mp := (5*doy + 2) / 153                                // [0, 11] from March
+	y := yoe + era*400
+	d := doy - (153*mp+2)/5 + 1
+	m := mp + 3
+	if m > 12 {
+		m -= 12
+		y++
+	}
+	return int(y), time.Month(m), int(d)
+}
+
+// daysFromCivil returns the number of days from January 1, 1970 to the given
+// proleptic Gregorian calendar date. It is the inverse of civilFromDays.
+func daysFromCivil(year int, month time.Month, day int) int64 {
+	y, m := int64(year), int64(month)
+	if m <= 2 {
+		y--
+	}
+	era := floorDiv(y, 400)
+	yoe := y - era*400
+	mp := (m + 9) % 12
+	doy := (153*mp+2)/5 + int64(day) - 1
+	doe := yoe*365 + yoe/4 - yoe/100 + doy
+	return era*146097 + doe - 719468
+}
+
+// floorDiv returns x divided by y rounded toward negative infinity.
+func floorDiv(x, y int64) int64 {
+	q := x / y
+	if (x%y != 0) && ((x < 0) != (y < 0)) {
+		q--
+	}
+	return q
+}
+
+// NextWeekday returns the first date after jd, at the same time of day, whose
+// UTC calendar day falls on the given weekday. If jd itself falls on w, the
+// date one week later is returned.
+func (jd Date) NextWeekday(w time.Weekday) Date {
+	n := floorMod(int64(w)-int64(jd.Weekday()), 7)
+	if n == 0 {
+		n = 7
+	}
+	return jd + Date(n)
+}
+
+// PreviousWeekday returns the last date before jd, at the same time of day,
+// whose UTC calendar day falls on the given weekday. If jd itself falls on w,
+// the date one week earlier is returned.
+func (jd Date) PreviousWeekday(w time.Weekday) Date {
+	n := floorMod(int64(jd.Weekday())-int64(w), 7)
+	if n == 0 {
+		n = 7
+	}
+	return jd - Date(n)
+}
+
+// DecimalYear returns jd as a decimal year of the proleptic Gregorian
+// calendar, the year plus the fraction of that calendar year elapsed since
+// midnight UTC on January 1. For example, noon UTC on July 2, 2021 is 2021.5.
+func (jd Date) DecimalYear() float64 {
+	y, _, _ := jd.Date()
+	start, length := yearSpan(y)
+	return float64(y) + float64(jd-start)/length
+}
+
+// FromDecimalYear returns the julian date of the decimal year y as returned
+// by DecimalYear.
+func FromDecimalYear(y float64) Date {
+	year := math.Floor(y)
+	start, length := yearSpan(int(year))
+	return start + Date((y-year)*length)
+}
+
+// yearSpan returns the julian date of the start of the given year and the
+// length of the year in days.
+func yearSpan(year int) (start Date, length float64) {
+	d0 := daysFromCivil(year, time.January, 1)
+	d1 := daysFromCivil(year+1, time.January, 1)
+	return fromCivil(d0, 0), float64(d1 - d0)
+}
+
+// julianFromDays returns the proleptic Julian calendar date that is the given
+// number of days after January 1, 1970 (Gregorian).
+func julianFromDays(days int64) (year int, month time.Month, day int) {
+	z := days + 719470 // days since 3/1/0000 in the julian calendar
+	era := floorDiv(z, 1461)
+	doe := z - era*1461           // [0, 1460]
+	yoe := (doe - doe/1460) / 365 // [0, 3]
+	doy := doe - 365*yoe          // [0, 365]
+	mp := (5*doy + 2) / 153       // [0, 11] from March
+	y := yoe + era*4
+	d := doy - (153*mp+2)/5 + 1
+	m := mp + 3
+	if m > 12 {
+		m -= 12
+		y++
+	}
+	return int(y), time.Month(m), int(d)
+}
+
+// daysFromJulian returns the number of days from January 1, 1970 (Gregorian)
+// to the given proleptic Julian calendar date. It is the inverse of
+// julianFromDays.
+func daysFromJulian(year int, month time.Month, day int) int64 {
+	y, m := int64(year), int64(month)
+	if m <= 2 {
+		y--
+	}
+	era := floorDiv(y, 4)
+	yoe := y - era*4
+	mp := (m + 9) % 12
+	doy := (153*mp+2)/5 + int64(day) - 1
+	return era*1461 + yoe*365 + doy - 719470
+}
+
+// isLeap reports whether year is a leap year in the calendar system.
+func isLeap(year int, cs CalendarSystem) bool {
+	if cs == ProlepticJulian {
+		return year%4 == 0
+	}
+	return year%4 == 0 && (year%100 != 0 || year%400 == 0)
+}
+
+// daysIn returns the number of days in the month of year in the calendar
+// system.
+func daysIn(m time.Month, year int, cs CalendarSystem) int {
+	switch m {
+	case time.February:
+		if isLeap(year, cs) {
+			return 29
+		}
+		return 28
+	case time.April, time.June, time.September, time.November:
+		return 30
+	}
+	return 31
+}
@@ -0,0 +1,171 @@
+package calendar
+
+import (
+	"math"
+
+	"github.com/pachecot/julian"
+)
+
+// BahaiCalendar is the Badíʿ calendar of the Baháʼí Faith: 19 months of 19
+// days, from Bahá (1) to ʿAlá (19), with the intercalary days of Ayyám-i-Há,
+// month 0 here, between the 18th and 19th months. The year starts on
+// Naw-Rúz. Up to BE 171 Naw-Rúz was fixed on March 21 (Gregorian); since
+// BE 172 (2015) it is the day on which the March equinox falls before sunset
+// in Tehran. BE 1 began on March 21, 1844.
+type BahaiCalendar struct{}
+
+// Bahai is the Badíʿ calendar.
+var Bahai BahaiCalendar
+
+const (
+	bahai_offset = 1843 // years from the Baháʼí era to the Gregorian
+	bahai_reform = 172  // first year whose Naw-Rúz follows the equinox
+
+	// the coordinates of Tehran, in degrees
+	tehran_latitude  = 35.696111
+	tehran_longitude = 51.423056
+
+	ayyam_i_ha = 0 // the month number of the intercalary days
+
+	// ayyam_i_ha_fields is the month number of the intercalary days in the
+	// Fields of the Calendar interface, which numbers months from 1.
+	ayyam_i_ha_fields = 20
+)
+
+// DaysInYear returns the number of days in year, 365 or 366.
+func (c BahaiCalendar) DaysInYear(year int) int {
+	return int(c.newYear(year+1) - c.newYear(year))
+}
+
+// IsLeapYear reports whether year has 366 days, and so 5 days of
+// Ayyám-i-Há instead of 4.
+func (c BahaiCalendar) IsLeapYear(year int) bool {
+	return c.DaysInYear(year) == 366
+}
+
+// DaysInMonth returns the number of days in the given month of year: 19, or
+// 4 or 5 for Ayyám-i-Há.
+func (c BahaiCalendar) DaysInMonth(year, month int) int {
+	if month == ayyam_i_ha {
+		return c.DaysInYear(year) - 19*19
+	}
+	return 19
+}
+
+// ToJD returns the julian date of the midnight UTC that starts the given
+// Baháʼí date. The month must be in the range [0, 19]; days outside the
+// month are counted on from its first day. The Baháʼí day begins at the
+// preceding sunset, which ToJD does not model.
+func (c BahaiCalendar) ToJD(year, month, day int) julian.Date {
+	return jdFromFixed(c.fixed(year, month, day))
+}
+
+// FromJD returns the Baháʼí date of the UTC day containing jd.
+func (c BahaiCalendar) FromJD(jd julian.Date) (year, month, day int) {
+	f := fixedFromJD(jd)
+	year = Gregorian.year(f) - bahai_offset
+	if f < c.newYear(year) {
+		year--
+	}
+	doy := f - c.newYear(year)
+	switch {
+	case doy < 18*19:
+		return year, int(doy/19) + 1, int(doy%19) + 1
+	case f >= c.newYear(year+1)-19:
+		return year, 19, int(f-c.newYear(year+1)+19) + 1
+	}
+	return year, ayyam_i_ha, int(doy-18*19) + 1
+}
+
+func (c BahaiCalendar) fixed(year, month, day int) int64 {
+	d := int64(day) - 1
+	switch month {
+	case ayyam_i_ha:
+		return c.newYear(year) + 18*19 + d
+	case 19:
+		return c.newYear(year+1) - 19 + d
+	}
+	return c.newYear(year) + 19*int64(month-1) + d
+}
+
+// newYear returns the fixed day of Naw-Rúz of year.
+func (BahaiCalendar) newYear(year int) int64 {
+	if year < bahai_reform {
+		return Gregorian.fixed(year+bahai_offset, 3, 21)
+	}
+	eq := equinox(year+bahai_offset, false)
+	f := fixedFromJD(eq + iran_offset/24)
+	if eq >= tehranSunset(f) {
+		f++
+	}
+	return f
+}
+
+// tehranSunset returns the UT julian date of sunset in Tehran on the fixed
+// day f, using the low-precision solar coordinates of Meeus, Astronomical
+// Algorithms, chapter 25, which are good to a minute or so.
+func tehranSunset(f int64) julian.Date {
+	const deg = math.Pi / 180
+	noon := jdFromFixed(f) + 0.5 - tehran_longitude/360
+	t := float64(noon-2451545) / 36525
+	l0 := (280.46646 + 36000.76983*t) * deg
+	m := (357.52911 + 35999.05029*t) * deg
+	e := 0.016708634 - 0.000042037*t
+	c := ((1.914602-0.004817*t)*math.Sin(m) + (0.019993-0.000101*t)*math.Sin(2*m) + 0.000289*math.Sin(3*m)) * deg
+	omega := (125.04 - 1934.136*t) * deg
+	lambda := l0 + c - (0.00569+0.00478*math.Sin(omega))*deg
+	eps := (23.439291 - 0.0130042*t + 0.00256*math.Cos(omega)) * deg
+	decl := math.Asin(math.Sin(eps) * math.Sin(lambda))
+
+	// the equation of time, apparent minus mean solar time, in radians
+	y := math.Pow(math.Tan(eps/2), 2)
+	eot := y*math.Sin(2*l0) - 2*e*math.Sin(m) + 4*e*y*math.Sin(m)*math.Cos(2*l0) -
+		y*y*math.Sin(4*l0)/2 - 1.25*e*e*math.Sin(2*m)
+
+	// the hour angle of the upper limb at the horizon, with refraction
+	lat := tehran_latitude * deg
+	h := math.Acos((math.Sin(-0.833*deg) - math.Sin(lat)*math.Sin(decl)) / (math.Cos(lat) * math.Cos(decl)))
+	return jdFromFixed(f) + julian.Date(0.5-tehran_longitude/360+(h-eot)/(2*math.Pi))
+}
+
+// Name returns the name of the calendar, "Baháʼí".
+func (BahaiCalendar) Name() string { return "Baháʼí" }
+
+// ToJDN returns the julian day number of the date. Ayyám-i-Há is month 20.
+func (c BahaiCalendar) ToJDN(f Fields) int64 {
+	if f.Month == ayyam_i_ha_fields {
+		f.Month = ayyam_i_ha
+	}
+	return toJDN(c.fixed(f.Year, f.Month, f.Day))
+}
+
+// FromJDN returns the date of the day with the julian day number jdn, with
+// Ayyám-i-Há as month 20.
+func (c BahaiCalendar) FromJDN(jdn int64) Fields {
+	y, m, d := c.FromJD(jdFromFixed(fromJDN(jdn)))
+	if m == ayyam_i_ha {
+		m = ayyam_i_ha_fields
+	}
+	return Fields{y, m, d}
+}
+
+// MonthsInYear returns 20, counting Ayyám-i-Há, month 20 in Fields, as a
+// month.
+func (BahaiCalendar) MonthsInYear(year int) int { return 20 }
+
+// MonthName returns the name of the month, e.g. "Bahá", or "Ayyám-i-Há"
+// for month 20 or 0.
+func (BahaiCalendar) MonthName(year, month int) string {
+	if month == ayyam_i_ha || month == ayyam_i_ha_fields {
+		return "Ayyám-i-Há"
+	}
+	return monthName(bahaiMonths[:], month)
+}
+
+var bahaiMonths = [...]string{
+	"Bahá", "Jalál", "Jamál", "ʿAẓamat", "Núr", "Raḥmat", "Kalimát", "Kamál", "Asmáʼ", "ʿIzzat",
+	"Mashíyyat", "ʿIlm", "Qudrat", "Qawl", "Masáʼil", "Sharaf", "Sulṭán", "Mulk", "ʿAláʼ",
+}
+
+// Era returns "BE", the Baháʼí Era.
+func (BahaiCalendar) Era() string { return "BE" }
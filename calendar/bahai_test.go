@@ -0,0 +1,74 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pachecot/julian"
+)
+
+func TestBahai(t *testing.T) {
+	tests := []struct {
+		name             string
+		jd               julian.Date
+		year, month, day int
+	}{
+		{"epoch", Gregorian.ToJD(1844, 3, 21), 1, 1, 1},
+		{"Naw-Rúz 171", Gregorian.ToJD(2014, 3, 21), 171, 1, 1},
+		{"Naw-Rúz 172", Gregorian.ToJD(2015, 3, 21), 172, 1, 1},
+		{"Naw-Rúz 173", Gregorian.ToJD(2016, 3, 20), 173, 1, 1},
+		{"Naw-Rúz 175", Gregorian.ToJD(2018, 3, 21), 175, 1, 1},
+		{"Naw-Rúz 179", Gregorian.ToJD(2022, 3, 21), 179, 1, 1},
+		{"Naw-Rúz 181", Gregorian.ToJD(2024, 3, 20), 181, 1, 1},
+		{"Naw-Rúz 182", Gregorian.ToJD(2025, 3, 20), 182, 1, 1},
+		{"Ayyám-i-Há 181", Gregorian.ToJD(2025, 2, 25), 181, 0, 1},
+		{"ʿAlá 181", Gregorian.ToJD(2025, 3, 1), 181, 19, 1},
+		{"last day 181", Gregorian.ToJD(2025, 3, 19), 181, 19, 19},
+		{"Mulk 18", Gregorian.ToJD(2025, 2, 24), 181, 18, 19},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Bahai.ToJD(tt.year, tt.month, tt.day); got != tt.jd {
+				t.Errorf("Bahai.ToJD(%v, %v, %v) = %v, want %v", tt.year, tt.month, tt.day, got, tt.jd)
+			}
+			y, m, d := Bahai.FromJD(tt.jd + 0.5)
+			if y != tt.year || m != tt.month || d != tt.day {
+				t.Errorf("Bahai.FromJD(%v) = %v, %v, %v, want %v, %v, %v", tt.jd+0.5, y, m, d, tt.year, tt.month, tt.day)
+			}
+		})
+	}
+	if got := Bahai.DaysInMonth(181, 0); got != 4 {
+		t.Errorf("Bahai.DaysInMonth(181, 0) = %v, want 4", got)
+	}
+}
+
+func TestTehranSunset(t *testing.T) {
+	// 18:16 Iran Standard Time on March 20, 2024
+	want := time.Date(2024, time.March, 20, 14, 46, 0, 0, time.UTC)
+	got := tehranSunset(fixedFromJD(Gregorian.ToJD(2024, 3, 20)))
+	if d := got.Sub(julian.Time(want)); d.Abs() > 2*time.Minute {
+		t.Errorf("tehranSunset(March 20, 2024) = %v, want %v", got.UTC(), want)
+	}
+}
+
+func TestBahai_roundTrip(t *testing.T) {
+	for jd := julian.Date(2_300_000.5); jd < 2_600_000; jd += 97 {
+		y, m, d := Bahai.FromJD(jd)
+		if got := Bahai.ToJD(y, m, d); got != jd {
+			t.Fatalf("Bahai.ToJD(Bahai.FromJD(%v)) = %v (%v, %v, %v)", jd, got, y, m, d)
+		}
+		if m < 0 || m > 19 || d < 1 || d > Bahai.DaysInMonth(y, m) {
+			t.Fatalf("Bahai.FromJD(%v) = %v, %v, %v, out of range", jd, y, m, d)
+		}
+	}
+}
+
+func TestBahai_fieldsAyyamIHa(t *testing.T) {
+	want := int64(Bahai.ToJD(181, 0, 1) + 0.5)
+	if got := Bahai.ToJDN(Fields{181, 20, 1}); got != want {
+		t.Errorf("Bahai.ToJDN(181, 20, 1) = %v, want %v", got, want)
+	}
+	if got := Bahai.FromJDN(want); got != (Fields{181, 20, 1}) {
+		t.Errorf("Bahai.FromJDN(%v) = %v, want {181 20 1}", want, got)
+	}
+}
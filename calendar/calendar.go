@@ -0,0 +1,53 @@
+// Package calendar converts julian dates to and from the dates of historical
+// and non-Gregorian calendars.
+//
+// Each calendar converts between a julian.Date and a (year, month, day)
+// triple. ToJD returns the julian date of the midnight UTC that starts the
+// calendar day, and FromJD returns the calendar date of the UTC day
+// containing a julian date. Internally days are counted as fixed day
+// numbers, with day 1 being January 1, 1 (Gregorian), following Dershowitz
+// and Reingold, Calendrical Calculations.
+//
+// The calendars whose dates are a year, month and day also implement the
+// Calendar interface and are registered for Lookup. Two are not: the
+// Japanese calendar, whose year means nothing without its era, which Fields
+// cannot carry, and the Mayan calendar, whose Long Count, Tzolk'in and Haab'
+// dates have no year, month and day form.
+package calendar
+
+import (
+	"math"
+
+	"github.com/pachecot/julian"
+)
+
+// rd_epoch is the julian date of the midnight that starts fixed day 0.
+const rd_epoch = 1721424.5
+
+// fixedFromJD returns the fixed day number of the UTC day containing jd.
+func fixedFromJD(jd julian.Date) int64 {
+	return int64(math.Floor(float64(jd - rd_epoch)))
+}
+
+// jdFromFixed returns the julian date of the midnight that starts the fixed
+// day number f.
+func jdFromFixed(f int64) julian.Date {
+	return julian.Date(f) + rd_epoch
+}
+
+// floorDiv and floorMod are copies of the unexported helpers of package
+// julian.
+
+// floorDiv returns x/y rounded toward negative infinity.
+func floorDiv(x, y int64) int64 {
+	q := x / y
+	if (x%y != 0) && ((x < 0) != (y < 0)) {
+		q--
+	}
+	return q
+}
+
+// floorMod returns x modulo y with the sign of y.
+func floorMod(x, y int64) int64 {
+	return x - y*floorDiv(x, y)
+}
@@ -0,0 +1,90 @@
+package calendar
+
+import "github.com/pachecot/julian"
+
+// A Computus holds the parameters that the medieval computus, the reckoning
+// of the date of Easter, and the chronologers attach to a year.
+type Computus struct {
+	Year            int    // the year, in the calendar in force
+	GoldenNumber    int    // the year of the 19-year lunar cycle, 1 to 19
+	Epact           int    // the epact, by the rule of the calendar, 0 to 29
+	DominicalLetter string // the letter of the Sundays, two in a leap year
+	SolarCycle      int    // the year of the 28-year solar cycle, 1 to 28
+	Indiction       int    // the year of the 15-year Roman indiction, 1 to 15
+}
+
+// GoldenNumber returns the year's place in the 19-year Metonic cycle, counted
+// from 1 BC (year 0).
+func GoldenNumber(year int) int { return int(floorMod(int64(year), 19)) + 1 }
+
+// SolarCycle returns the year's place in the 28-year cycle after which the
+// days of the week recur on the same dates of the Julian calendar, with
+// 9 BC (year -8) the first year of a cycle.
+func SolarCycle(year int) int { return int(floorMod(int64(year)+8, 28)) + 1 }
+
+// Indiction returns the year's place in the 15-year cycle of the Roman
+// indiction, with 3 BC (year -2) the first year of a cycle.
+func Indiction(year int) int { return int(floorMod(int64(year)+2, 15)) + 1 }
+
+// Epact returns the Julian epact of the year, the age of the moon on
+// March 22 by the Dionysian tables, which is 0 in the first year of the
+// lunar cycle.
+func (JulianCalendar) Epact(year int) int {
+	return int(floorMod(11*int64(GoldenNumber(year)-1), 30))
+}
+
+// Epact returns the Gregorian epact of the year, the Julian epact corrected
+// by the solar equation for the dropped leap days and the lunar equation for
+// the drift of the Metonic cycle. The epact 0 is written *.
+func (GregorianCalendar) Epact(year int) int {
+	c := floorDiv(int64(year), 100) + 1
+	solar := floorDiv(3*c, 4)
+	lunar := floorDiv(8*c+5, 25)
+	return int(floorMod(11*int64(GoldenNumber(year)-1)-solar+lunar+8, 30))
+}
+
+// DominicalLetter returns the dominical letter of the year, the letter of the
+// Sundays when the days from January 1 are lettered A to G in turn. A leap
+// year has two, the first for January and February and the second for the
+// rest of the year.
+func (c JulianCalendar) DominicalLetter(year int) string {
+	return dominicalLetter(c.fixed(year, 1, 1), c.IsLeapYear(year))
+}
+
+// DominicalLetter returns the dominical letter of the year, as for the
+// Julian calendar.
+func (c GregorianCalendar) DominicalLetter(year int) string {
+	return dominicalLetter(c.fixed(year, 1, 1), c.IsLeapYear(year))
+}
+
+// dominicalLetter returns the letter of the first Sunday on or after the
+// fixed day newYear, followed by the letter before it in a leap year.
+func dominicalLetter(newYear int64, leap bool) string {
+	// Fixed day 0 is a Sunday, so the first Sunday is newYear+k.
+	k := floorMod(-newYear, 7)
+	s := string(rune('A' + k))
+	if leap {
+		s += string(rune('A' + (k+6)%7))
+	}
+	return s
+}
+
+// Computus returns the computus parameters of the year containing the UTC
+// day of jd, with the year, epact and dominical letter taken in the calendar
+// in force on that day.
+func (c HistoricalCalendar) Computus(jd julian.Date) Computus {
+	var p Computus
+	if c.IsGregorian(jd) {
+		p.Year, _, _ = Gregorian.FromJD(jd)
+		p.Epact = Gregorian.Epact(p.Year)
+		p.DominicalLetter = Gregorian.DominicalLetter(p.Year)
+	} else {
+		p.Year, _, _ = Julian.FromJD(jd)
+		p.Epact = Julian.Epact(p.Year)
+		p.DominicalLetter = Julian.DominicalLetter(p.Year)
+	}
+	p.GoldenNumber = GoldenNumber(p.Year)
+	p.SolarCycle = SolarCycle(p.Year)
+	p.Indiction = Indiction(p.Year)
+	return p
+}
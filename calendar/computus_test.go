@@ -0,0 +1,46 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pachecot/julian"
+)
+
+func TestComputus(t *testing.T) {
+	tests := []struct {
+		name string
+		c    HistoricalCalendar
+		jd   julian.Date
+		want Computus
+	}{
+		{"2024", British, Gregorian.ToJD(2024, 6, 1), Computus{2024, 11, 19, "GF", 17, 2}},
+		{"2000", Papal, Gregorian.ToJD(2000, 1, 1), Computus{2000, 6, 24, "BA", 21, 8}},
+		{"1900", British, Gregorian.ToJD(1900, 12, 31), Computus{1900, 1, 29, "G", 5, 13}},
+		{"1732 Old Style", British, Julian.ToJD(1732, 2, 11), Computus{1732, 4, 3, "BA", 5, 10}},
+		{"1732 New Style", Papal, Julian.ToJD(1732, 2, 11), Computus{1732, 4, 3, "FE", 5, 10}},
+		{"AD 1", British, Julian.ToJD(1, 1, 1), Computus{1, 2, 11, "B", 10, 4}},
+		{"1 BC", British, Julian.ToJD(0, 7, 1), Computus{0, 1, 0, "DC", 9, 3}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.c.Computus(tt.jd + 0.5); got != tt.want {
+				t.Errorf("Computus(%v) = %+v, want %+v", tt.jd+0.5, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDominicalLetter_gregorian(t *testing.T) {
+	for year := 1583; year < 2400; year++ {
+		first := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+		k := (7 - int(first.Weekday())) % 7
+		want := string(rune('A' + k))
+		if Gregorian.IsLeapYear(year) {
+			want += string(rune('A' + (k+6)%7))
+		}
+		if got := Gregorian.DominicalLetter(year); got != want {
+			t.Fatalf("Gregorian.DominicalLetter(%v) = %q, want %q", year, got, want)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package calendar
+
+import "github.com/pachecot/julian"
+
+// CopticCalendar is the calendar of the Coptic Orthodox Church, with twelve
+// months of 30 days and an epagomenal thirteenth month of 5 days, or 6 in a
+// leap year. Years are counted in the Era of the Martyrs (Anno Martyrum),
+// AM 1 starting on August 29, 284 (Julian).
+type CopticCalendar struct{}
+
+// Coptic is the Coptic calendar.
+var Coptic CopticCalendar
+
+const coptic_epoch = 103_605 // fixed day of 1 Thout AM 1
+
+// IsLeapYear reports whether year has 366 days, which as in the Julian
+// calendar is every fourth year, the year before the Julian leap year.
+func (CopticCalendar) IsLeapYear(year int) bool {
+	return floorMod(int64(year), 4) == 3
+}
+
+// DaysInMonth returns the number of days in the given month of year.
+func (c CopticCalendar) DaysInMonth(year, month int) int {
+	return copticDaysInMonth(year, month, c.IsLeapYear(year))
+}
+
+// ToJD returns the julian date of the midnight UTC that starts the given
+// Coptic date. The month must be in the range [1, 13]; days outside the
+// month are counted on from its first day.
+func (CopticCalendar) ToJD(year, month, day int) julian.Date {
+	return jdFromFixed(copticFixed(coptic_epoch, year, month, day))
+}
+
+// FromJD returns the Coptic date of the UTC day containing jd.
+func (CopticCalendar) FromJD(jd julian.Date) (year, month, day int) {
+	return copticFromFixed(coptic_epoch, fixedFromJD(jd))
+}
+
+// copticDaysInMonth returns the length of a month of the Coptic and
+// Ethiopian calendars.
+func copticDaysInMonth(year, month int, leap bool) int {
+	switch {
+	case month < 13:
+		return 30
+	case leap:
+		return 6
+	}
+	return 5
+}
+
+// copticFixed returns the fixed day of a date in a calendar with the Coptic
+// structure that starts at the given fixed day.
+func copticFixed(epoch int64, year, month, day int) int64 {
+	y := int64(year)
+	return epoch - 1 + 365*(y-1) + floorDiv(y, 4) + 30*int64(month-1) + int64(day)
+}
+
+// copticFromFixed is the inverse of copticFixed.
+func copticFromFixed(epoch, f int64) (year, month, day int) {
+	year = int(floorDiv(4*(f-epoch)+1463, 1461))
+	doy := f - copticFixed(epoch, year, 1, 1)
+	return year, int(doy/30) + 1, int(doy%30) + 1
+}
+
+// Name returns the name of the calendar, "Coptic".
+func (CopticCalendar) Name() string { return "Coptic" }
+
+// ToJDN returns the julian day number of the date.
+func (CopticCalendar) ToJDN(f Fields) int64 {
+	return toJDN(copticFixed(coptic_epoch, f.Year, f.Month, f.Day))
+}
+
+// FromJDN returns the date of the day with the julian day number jdn.
+func (CopticCalendar) FromJDN(jdn int64) Fields {
+	y, m, d := copticFromFixed(coptic_epoch, fromJDN(jdn))
+	return Fields{y, m, d}
+}
+
+// MonthsInYear returns 13, counting the epagomenal days as a month.
+func (CopticCalendar) MonthsInYear(year int) int { return 13 }
+
+// MonthName returns the name of the month, e.g. "Thout".
+func (CopticCalendar) MonthName(year, month int) string {
+	return monthName(copticMonths[:], month)
+}
+
+var copticMonths = [...]string{
+	"Thout", "Paopi", "Hathor", "Koiak", "Tobi", "Meshir", "Paremhat",
+	"Parmouti", "Pashons", "Paoni", "Epip", "Mesori", "Pi Kogi Enavot",
+}
+
+// Era returns "AM", Anno Martyrum.
+func (CopticCalendar) Era() string { return "AM" }
@@ -0,0 +1,45 @@
+package calendar
+
+import (
+	"testing"
+
+	"github.com/pachecot/julian"
+)
+
+func TestCoptic(t *testing.T) {
+	tests := []struct {
+		name             string
+		jd               julian.Date
+		year, month, day int
+	}{
+		{"epoch", Julian.ToJD(284, 8, 29), 1, 1, 1},
+		{"586 BC", jdFromFixed(-214_193), -870, 12, 6},
+		{"Nayrouz 1740", Gregorian.ToJD(2023, 9, 12), 1740, 1, 1},
+		{"leap day 1739", Gregorian.ToJD(2023, 9, 11), 1739, 13, 6},
+		{"Nayrouz 1741", Gregorian.ToJD(2024, 9, 11), 1741, 1, 1},
+		{"Christmas 1741", Gregorian.ToJD(2025, 1, 7), 1741, 4, 29},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Coptic.ToJD(tt.year, tt.month, tt.day); got != tt.jd {
+				t.Errorf("Coptic.ToJD(%v, %v, %v) = %v, want %v", tt.year, tt.month, tt.day, got, tt.jd)
+			}
+			y, m, d := Coptic.FromJD(tt.jd + 0.5)
+			if y != tt.year || m != tt.month || d != tt.day {
+				t.Errorf("Coptic.FromJD(%v) = %v, %v, %v, want %v, %v, %v", tt.jd+0.5, y, m, d, tt.year, tt.month, tt.day)
+			}
+		})
+	}
+}
+
+func TestCoptic_roundTrip(t *testing.T) {
+	for jd := julian.Date(-800_000.5); jd < 4_000_000; jd += 997 {
+		y, m, d := Coptic.FromJD(jd)
+		if got := Coptic.ToJD(y, m, d); got != jd {
+			t.Fatalf("Coptic.ToJD(Coptic.FromJD(%v)) = %v (%v, %v, %v)", jd, got, y, m, d)
+		}
+		if m < 1 || m > 13 || d < 1 || d > Coptic.DaysInMonth(y, m) {
+			t.Fatalf("Coptic.FromJD(%v) = %v, %v, %v, out of range", jd, y, m, d)
+		}
+	}
+}
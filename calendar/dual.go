@@ -0,0 +1,63 @@
+package calendar
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/pachecot/julian"
+)
+
+// A DualDate is a day written in both the Old Style (Julian) and the New
+// Style (Gregorian) calendars, as found in records of the years around a
+// switchover.
+type DualDate struct {
+	OldStyle  Fields // the Julian date, with the year beginning January 1
+	NewStyle  Fields // the Gregorian date
+	Year      int    // the Old Style year in force, by the calendar's year start
+	Gregorian bool   // whether the New Style is in force
+}
+
+// DualDate returns the Old Style and New Style dates of the UTC day
+// containing jd. Before LadyDayUntil the Old Style year began on Lady Day,
+// March 25, so that with the British calendar February 11, 1732 (Julian)
+// falls in the year 1731/32, while every date from January 1, 1752 on is in
+// a year beginning January 1. Where LadyDayUntil is zero the year always
+// begins on January 1.
+func (c HistoricalCalendar) DualDate(jd julian.Date) DualDate {
+	var d DualDate
+	d.OldStyle.Year, d.OldStyle.Month, d.OldStyle.Day = Julian.FromJD(jd)
+	d.NewStyle.Year, d.NewStyle.Month, d.NewStyle.Day = Gregorian.FromJD(jd)
+	d.Gregorian = c.IsGregorian(jd)
+	d.Year = d.OldStyle.Year
+	if jdFromFixed(fixedFromJD(jd)) < c.LadyDayUntil &&
+		(d.OldStyle.Month < 3 || d.OldStyle.Month == 3 && d.OldStyle.Day < 25) {
+		d.Year--
+	}
+	return d
+}
+
+// DualYear returns the year in the dual-dated form, e.g. "1731/32" for a
+// date between January 1 and March 24 when the Old Style year had not yet
+// turned, and the plain year otherwise. The second year is abbreviated to
+// its last two digits when both are in the same century.
+func (d DualDate) DualYear() string {
+	if d.Year == d.OldStyle.Year {
+		return strconv.Itoa(d.Year)
+	}
+	next := d.OldStyle.Year
+	if d.Year >= 0 && d.Year/100 == next/100 {
+		return fmt.Sprintf("%d/%02d", d.Year, next%100)
+	}
+	return fmt.Sprintf("%d/%d", d.Year, next)
+}
+
+// String returns the date in the calendar in force with the dual-dated
+// year, e.g. "11 February 1731/32", or the New Style date, e.g.
+// "14 September 1752", once the switchover has passed.
+func (d DualDate) String() string {
+	if d.Gregorian {
+		return fmt.Sprintf("%d %v %d", d.NewStyle.Day, time.Month(d.NewStyle.Month), d.NewStyle.Year)
+	}
+	return fmt.Sprintf("%d %v %s", d.OldStyle.Day, time.Month(d.OldStyle.Month), d.DualYear())
+}
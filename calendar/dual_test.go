@@ -0,0 +1,52 @@
+package calendar
+
+import (
+	"testing"
+
+	"github.com/pachecot/julian"
+)
+
+func TestDualDate(t *testing.T) {
+	// Scotland moved the start of the year to January 1 in 1600 but kept
+	// the Julian calendar until 1752 with England.
+	scottish := HistoricalCalendar{Switch: British.Switch, LadyDayUntil: Julian.ToJD(1600, 1, 1)}
+	tests := []struct {
+		name     string
+		c        HistoricalCalendar
+		jd       julian.Date
+		os, ns   Fields
+		year     int
+		dualYear string
+		str      string
+	}{
+		{"Washington's birth", British, Julian.ToJD(1732, 2, 11), Fields{1732, 2, 11}, Fields{1732, 2, 22}, 1731, "1731/32", "11 February 1731/32"},
+		{"New Year's Day", British, Julian.ToJD(1700, 1, 1), Fields{1700, 1, 1}, Fields{1700, 1, 11}, 1699, "1699/1700", "1 January 1699/1700"},
+		{"last day of 1750", British, Julian.ToJD(1751, 3, 24), Fields{1751, 3, 24}, Fields{1751, 4, 4}, 1750, "1750/51", "24 March 1750/51"},
+		{"Lady Day", British, Julian.ToJD(1751, 3, 25), Fields{1751, 3, 25}, Fields{1751, 4, 5}, 1751, "1751", "25 March 1751"},
+		{"Christmas", British, Julian.ToJD(1751, 12, 25), Fields{1751, 12, 25}, Fields{1752, 1, 5}, 1751, "1751", "25 December 1751"},
+		{"January 1752", British, Julian.ToJD(1752, 1, 1), Fields{1752, 1, 1}, Fields{1752, 1, 12}, 1752, "1752", "1 January 1752"},
+		{"last Old Style day", British, Julian.ToJD(1752, 9, 2), Fields{1752, 9, 2}, Fields{1752, 9, 13}, 1752, "1752", "2 September 1752"},
+		{"first New Style day", British, Gregorian.ToJD(1752, 9, 14), Fields{1752, 9, 3}, Fields{1752, 9, 14}, 1752, "1752", "14 September 1752"},
+		{"Papal", Papal, Julian.ToJD(1582, 10, 4), Fields{1582, 10, 4}, Fields{1582, 10, 14}, 1582, "1582", "4 October 1582"},
+		{"Russian", Russian, Julian.ToJD(1917, 2, 1), Fields{1917, 2, 1}, Fields{1917, 2, 14}, 1917, "1917", "1 February 1917"},
+		{"Russian New Style", Russian, Gregorian.ToJD(1918, 2, 14), Fields{1918, 2, 1}, Fields{1918, 2, 14}, 1918, "1918", "14 February 1918"},
+		{"Papal Old Style", Papal, Julian.ToJD(1581, 2, 1), Fields{1581, 2, 1}, Fields{1581, 2, 11}, 1581, "1581", "1 February 1581"},
+		{"Papal New Style", Papal, Gregorian.ToJD(1600, 2, 1), Fields{1600, 1, 22}, Fields{1600, 2, 1}, 1600, "1600", "1 February 1600"},
+		{"Scottish Lady Day", scottish, Julian.ToJD(1599, 3, 1), Fields{1599, 3, 1}, Fields{1599, 3, 11}, 1598, "1598/99", "1 March 1598/99"},
+		{"Scottish January", scottish, Julian.ToJD(1600, 3, 1), Fields{1600, 3, 1}, Fields{1600, 3, 11}, 1600, "1600", "1 March 1600"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := tt.c.DualDate(tt.jd + 0.5)
+			if d.OldStyle != tt.os || d.NewStyle != tt.ns || d.Year != tt.year {
+				t.Errorf("DualDate(%v) = %+v, want OS %v, NS %v, year %v", tt.jd+0.5, d, tt.os, tt.ns, tt.year)
+			}
+			if s := d.DualYear(); s != tt.dualYear {
+				t.Errorf("DualYear() = %q, want %q", s, tt.dualYear)
+			}
+			if s := d.String(); s != tt.str {
+				t.Errorf("String() = %q, want %q", s, tt.str)
+			}
+		})
+	}
+}
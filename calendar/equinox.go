@@ -0,0 +1,75 @@
+package calendar
+
+import (
+	"math"
+
+	"github.com/pachecot/julian"
+)
+
+// equinox returns the UT julian date of the March equinox of the Gregorian
+// year, or of the September equinox if september is set, by the method of
+// Meeus, Astronomical Algorithms, chapter 27. The result is good to about a
+// minute between the years 1000 and 3000 and degrades slowly outside them.
+func equinox(year int, september bool) julian.Date {
+	var jde0 float64
+	if year >= 1000 {
+		y := float64(year-2000) / 1000
+		if september {
+			jde0 = 2451810.21715 + y*(365242.01767+y*(-0.11575+y*(0.00337+y*0.00078)))
+		} else {
+			jde0 = 2451623.80984 + y*(365242.37404+y*(0.05169+y*(-0.00411+y*-0.00057)))
+		}
+	} else {
+		y := float64(year) / 1000
+		if september {
+			jde0 = 1721325.70455 + y*(365242.49558+y*(-0.11677+y*(-0.00297+y*0.00074)))
+		} else {
+			jde0 = 1721139.29189 + y*(365242.13740+y*(0.06134+y*(0.00111+y*-0.00071)))
+		}
+	}
+	const deg = math.Pi / 180
+	t := (jde0 - 2451545) / 36525
+	w := (35999.373*t - 2.47) * deg
+	dl := 1 + 0.0334*math.Cos(w) + 0.0007*math.Cos(2*w)
+	var s float64
+	for _, p := range equinoxTerms {
+		s += p[0] * math.Cos((p[1]+p[2]*t)*deg)
+	}
+	jde := julian.Date(jde0 + 0.00001*s/dl)
+	return jde - julian.Date(julian.DeltaT(jde)/86400)
+}
+
+// equinoxTerms are the periodic terms A, B, C of Meeus's table 27.C.
+var equinoxTerms = [...][3]float64{
+	{485, 324.96, 1934.136},
+	{203, 337.23, 32964.467},
+	{199, 342.08, 20.186},
+	{182, 27.85, 445267.112},
+	{156, 73.14, 45036.886},
+	{136, 171.52, 22518.443},
+	{77, 222.54, 65928.934},
+	{74, 296.72, 3034.906},
+	{70, 243.58, 9037.513},
+	{58, 119.81, 33718.147},
+	{52, 297.17, 150.678},
+	{50, 21.02, 2281.226},
+	{45, 247.54, 29929.562},
+	{44, 325.15, 31555.956},
+	{29, 60.93, 4443.417},
+	{18, 155.12, 67555.328},
+	{17, 288.79, 4562.452},
+	{16, 198.04, 62894.029},
+	{14, 199.76, 31436.921},
+	{12, 95.39, 14577.848},
+	{12, 287.11, 31931.756},
+	{12, 320.81, 34777.259},
+	{9, 227.73, 1222.114},
+	{8, 15.45, 16859.074},
+}
+
+// noonDay returns the fixed day on which an instant falls if it is
+// before noon local time at the given UTC offset in hours, and the next day
+// otherwise.
+func noonDay(jd julian.Date, offset float64) int64 {
+	return fixedFromJD(jd + julian.Date(offset/24) + 0.5)
+}
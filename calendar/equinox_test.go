@@ -0,0 +1,27 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pachecot/julian"
+)
+
+func TestEquinox(t *testing.T) {
+	tests := []struct {
+		year      int
+		september bool
+		want      time.Time
+	}{
+		{2024, false, time.Date(2024, time.March, 20, 3, 6, 0, 0, time.UTC)},
+		{2024, true, time.Date(2024, time.September, 22, 12, 44, 0, 0, time.UTC)},
+		{2000, false, time.Date(2000, time.March, 20, 7, 35, 0, 0, time.UTC)},
+		{1792, true, time.Date(1792, time.September, 22, 9, 9, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		got := equinox(tt.year, tt.september)
+		if d := got.Sub(julian.Time(tt.want)); d.Abs() > 10*time.Minute {
+			t.Errorf("equinox(%v, %v) = %v, want %v", tt.year, tt.september, got.UTC(), tt.want)
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package calendar
+
+import "github.com/pachecot/julian"
+
+// An EthiopianCalendar is the civil calendar of Ethiopia, which has the
+// structure of the Coptic calendar: twelve months of 30 days and Pagume, of
+// 5 days or 6 in a leap year. Years are counted in the Era of Mercy (Amete
+// Mihret), year 1 starting on August 29, 8 (Julian), or in the Era of the
+// World (Amete Alem), which is 5500 years earlier.
+type EthiopianCalendar struct {
+	// AmeteAlem selects the Era of the World in place of the Era of Mercy.
+	AmeteAlem bool
+}
+
+var (
+	// Ethiopian is the Ethiopian calendar counted in the Era of Mercy.
+	Ethiopian = EthiopianCalendar{}
+
+	// EthiopianAmeteAlem is the Ethiopian calendar counted in the Era of
+	// the World.
+	EthiopianAmeteAlem = EthiopianCalendar{AmeteAlem: true}
+)
+
+const (
+	ethiopian_epoch = 2796 // fixed day of 1 Meskerem, Amete Mihret 1
+	amete_alem      = 5500 // years from the Era of the World to the Era of Mercy
+)
+
+// mihret returns year converted to the Era of Mercy.
+func (c EthiopianCalendar) mihret(year int) int {
+	if c.AmeteAlem {
+		return year - amete_alem
+	}
+	return year
+}
+
+// IsLeapYear reports whether year has 366 days, every fourth year.
+func (c EthiopianCalendar) IsLeapYear(year int) bool {
+	return floorMod(int64(c.mihret(year)), 4) == 3
+}
+
+// DaysInMonth returns the number of days in the given month of year.
+func (c EthiopianCalendar) DaysInMonth(year, month int) int {
+	return copticDaysInMonth(year, month, c.IsLeapYear(year))
+}
+
+// ToJD returns the julian date of the midnight UTC that starts the given
+// Ethiopian date. The month must be in the range [1, 13]; days outside the
+// month are counted on from its first day.
+func (c EthiopianCalendar) ToJD(year, month, day int) julian.Date {
+	return jdFromFixed(copticFixed(ethiopian_epoch, c.mihret(year), month, day))
+}
+
+// FromJD returns the Ethiopian date of the UTC day containing jd.
+func (c EthiopianCalendar) FromJD(jd julian.Date) (year, month, day int) {
+	year, month, day = copticFromFixed(ethiopian_epoch, fixedFromJD(jd))
+	if c.AmeteAlem {
+		year += amete_alem
+	}
+	return year, month, day
+}
+
+// Name returns the name of the calendar, "Ethiopian" or "Ethiopian (Amete
+// Alem)".
+func (c EthiopianCalendar) Name() string {
+	if c.AmeteAlem {
+		return "Ethiopian (Amete Alem)"
+	}
+	return "Ethiopian"
+}
+
+// ToJDN returns the julian day number of the date.
+func (c EthiopianCalendar) ToJDN(f Fields) int64 {
+	return toJDN(copticFixed(ethiopian_epoch, c.mihret(f.Year), f.Month, f.Day))
+}
+
+// FromJDN returns the date of the day with the julian day number jdn.
+func (c EthiopianCalendar) FromJDN(jdn int64) Fields {
+	y, m, d := c.FromJD(jdFromFixed(fromJDN(jdn)))
+	return Fields{y, m, d}
+}
+
+// MonthsInYear returns 13, counting Pagume as a month.
+func (EthiopianCalendar) MonthsInYear(year int) int { return 13 }
+
+// MonthName returns the name of the month, e.g. "Meskerem".
+func (EthiopianCalendar) MonthName(year, month int) string {
+	return monthName(ethiopianMonths[:], month)
+}
+
+var ethiopianMonths = [...]string{
+	"Meskerem", "Tikimt", "Hidar", "Tahsas", "Tir", "Yekatit", "Megabit",
+	"Miyazya", "Ginbot", "Sene", "Hamle", "Nehasse", "Pagume",
+}
+
+// Era returns "AM" for Amete Mihret or "AA" for Amete Alem.
+func (c EthiopianCalendar) Era() string {
+	if c.AmeteAlem {
+		return "AA"
+	}
+	return "AM"
+}
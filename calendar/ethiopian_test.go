@@ -0,0 +1,57 @@
+package calendar
+
+import (
+	"testing"
+
+	"github.com/pachecot/julian"
+)
+
+func TestEthiopian(t *testing.T) {
+	tests := []struct {
+		name             string
+		jd               julian.Date
+		year, month, day int
+	}{
+		{"epoch", Julian.ToJD(8, 8, 29), 1, 1, 1},
+		{"Enkutatash 2016", Gregorian.ToJD(2023, 9, 12), 2016, 1, 1},
+		{"Pagume 6, 2015", Gregorian.ToJD(2023, 9, 11), 2015, 13, 6},
+		{"Enkutatash 2017", Gregorian.ToJD(2024, 9, 11), 2017, 1, 1},
+		{"Genna 2017", Gregorian.ToJD(2025, 1, 7), 2017, 4, 29},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, cal := range []EthiopianCalendar{Ethiopian, EthiopianAmeteAlem} {
+				year := tt.year
+				if cal.AmeteAlem {
+					year += 5500
+				}
+				if got := cal.ToJD(year, tt.month, tt.day); got != tt.jd {
+					t.Errorf("%+v.ToJD(%v, %v, %v) = %v, want %v", cal, year, tt.month, tt.day, got, tt.jd)
+				}
+				y, m, d := cal.FromJD(tt.jd + 0.5)
+				if y != year || m != tt.month || d != tt.day {
+					t.Errorf("%+v.FromJD(%v) = %v, %v, %v, want %v, %v, %v", cal, tt.jd+0.5, y, m, d, year, tt.month, tt.day)
+				}
+			}
+		})
+	}
+	if !Ethiopian.IsLeapYear(2015) || !EthiopianAmeteAlem.IsLeapYear(7515) || Ethiopian.IsLeapYear(2016) {
+		t.Error("Ethiopian.IsLeapYear() disagrees with 2015 being the leap year")
+	}
+	if got := Ethiopian.DaysInMonth(2015, 13); got != 6 {
+		t.Errorf("Ethiopian.DaysInMonth(2015, 13) = %v, want 6", got)
+	}
+}
+
+func TestEthiopian_coptic(t *testing.T) {
+	for jd := julian.Date(-800_000.5); jd < 4_000_000; jd += 997 {
+		y, m, d := Ethiopian.FromJD(jd)
+		cy, cm, cd := Coptic.FromJD(jd)
+		if y-cy != 276 || m != cm || d != cd {
+			t.Fatalf("Ethiopian.FromJD(%v) = %v, %v, %v, Coptic %v, %v, %v", jd, y, m, d, cy, cm, cd)
+		}
+		if got := Ethiopian.ToJD(y, m, d); got != jd {
+			t.Fatalf("Ethiopian.ToJD(Ethiopian.FromJD(%v)) = %v", jd, got)
+		}
+	}
+}
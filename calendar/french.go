@@ -0,0 +1,115 @@
+package calendar
+
+import "github.com/pachecot/julian"
+
+// A FrenchRepublicanCalendar is the calendar of the French Revolution, in
+// use from 1793 to 1805. It has twelve months of 30 days, from Vendémiaire
+// (1) to Fructidor (12), followed by the complementary days (month 13), 5
+// or 6 of them. The year I began on September 22, 1792 (Gregorian).
+type FrenchRepublicanCalendar struct {
+	// Romme selects the arithmetic rule proposed by Gilbert Romme, with the
+	// leap years of the Gregorian calendar and of every 4000th year
+	// omitted, in place of the official rule that starts each year on the
+	// day of the autumnal equinox at Paris.
+	Romme bool
+}
+
+var (
+	// FrenchRepublican is the French Republican calendar with the equinox
+	// rule of the decree of 4 Frimaire II, as actually used.
+	FrenchRepublican = FrenchRepublicanCalendar{}
+
+	// FrenchRepublicanRomme is the French Republican calendar with Romme's
+	// arithmetic rule.
+	FrenchRepublicanRomme = FrenchRepublicanCalendar{Romme: true}
+)
+
+const (
+	french_epoch = 654_415      // fixed day of 1 Vendémiaire I
+	paris_offset = 561.0 / 3600 // Paris mean time, UTC+0:09:21, in hours
+)
+
+// IsLeapYear reports whether year has 366 days.
+func (c FrenchRepublicanCalendar) IsLeapYear(year int) bool {
+	if !c.Romme {
+		return c.newYear(year+1)-c.newYear(year) == 366
+	}
+	y := int64(year)
+	switch floorMod(y, 400) {
+	case 100, 200, 300:
+		return false
+	}
+	return floorMod(y, 4) == 0 && floorMod(y, 4000) != 0
+}
+
+// DaysInMonth returns the number of days in the given month of year.
+func (c FrenchRepublicanCalendar) DaysInMonth(year, month int) int {
+	return copticDaysInMonth(year, month, c.IsLeapYear(year))
+}
+
+// ToJD returns the julian date of the midnight UTC that starts the given
+// French Republican date. The month must be in the range [1, 13]; days
+// outside the month are counted on from its first day.
+func (c FrenchRepublicanCalendar) ToJD(year, month, day int) julian.Date {
+	return jdFromFixed(c.newYear(year) + 30*int64(month-1) + int64(day) - 1)
+}
+
+// FromJD returns the French Republican date of the UTC day containing jd.
+func (c FrenchRepublicanCalendar) FromJD(jd julian.Date) (year, month, day int) {
+	f := fixedFromJD(jd)
+	// the mean year of Romme's rule is 1460969/4000 days
+	year = int(floorDiv((f-french_epoch)*4000, 1_460_969)) + 1
+	for c.newYear(year) > f {
+		year--
+	}
+	for c.newYear(year+1) <= f {
+		year++
+	}
+	doy := f - c.newYear(year)
+	return year, int(doy/30) + 1, int(doy%30) + 1
+}
+
+// newYear returns the fixed day of 1 Vendémiaire of year.
+func (c FrenchRepublicanCalendar) newYear(year int) int64 {
+	if !c.Romme {
+		return fixedFromJD(equinox(year+1791, true) + paris_offset/24)
+	}
+	y := int64(year) - 1
+	return french_epoch + 365*y + floorDiv(y, 4) - floorDiv(y, 100) + floorDiv(y, 400) - floorDiv(y, 4000)
+}
+
+// Name returns the name of the calendar, "French Republican" or "French
+// Republican (Romme)".
+func (c FrenchRepublicanCalendar) Name() string {
+	if c.Romme {
+		return "French Republican (Romme)"
+	}
+	return "French Republican"
+}
+
+// ToJDN returns the julian day number of the date.
+func (c FrenchRepublicanCalendar) ToJDN(f Fields) int64 {
+	return toJDN(fixedFromJD(c.ToJD(f.Year, f.Month, f.Day)))
+}
+
+// FromJDN returns the date of the day with the julian day number jdn.
+func (c FrenchRepublicanCalendar) FromJDN(jdn int64) Fields {
+	y, m, d := c.FromJD(jdFromFixed(fromJDN(jdn)))
+	return Fields{y, m, d}
+}
+
+// MonthsInYear returns 13, counting the complementary days as a month.
+func (FrenchRepublicanCalendar) MonthsInYear(year int) int { return 13 }
+
+// MonthName returns the name of the month, e.g. "Thermidor".
+func (FrenchRepublicanCalendar) MonthName(year, month int) string {
+	return monthName(frenchMonths[:], month)
+}
+
+var frenchMonths = [...]string{
+	"Vendémiaire", "Brumaire", "Frimaire", "Nivôse", "Pluviôse", "Ventôse", "Germinal",
+	"Floréal", "Prairial", "Messidor", "Thermidor", "Fructidor", "Sansculottides",
+}
+
+// Era returns "ER", the Ère républicaine.
+func (FrenchRepublicanCalendar) Era() string { return "ER" }
@@ -0,0 +1,73 @@
+package calendar
+
+import (
+	"testing"
+
+	"github.com/pachecot/julian"
+)
+
+func TestFrenchRepublican(t *testing.T) {
+	tests := []struct {
+		name             string
+		cal              FrenchRepublicanCalendar
+		jd               julian.Date
+		year, month, day int
+	}{
+		{"epoch", FrenchRepublican, Gregorian.ToJD(1792, 9, 22), 1, 1, 1},
+		{"epoch Romme", FrenchRepublicanRomme, Gregorian.ToJD(1792, 9, 22), 1, 1, 1},
+		{"9 Thermidor II", FrenchRepublican, Gregorian.ToJD(1794, 7, 27), 2, 11, 9},
+		{"9 Thermidor II Romme", FrenchRepublicanRomme, Gregorian.ToJD(1794, 7, 27), 2, 11, 9},
+		{"jour de la Révolution III", FrenchRepublican, Gregorian.ToJD(1795, 9, 22), 3, 13, 6},
+		{"1 Vendémiaire IV", FrenchRepublican, Gregorian.ToJD(1795, 9, 23), 4, 1, 1},
+		{"1 Vendémiaire IV Romme", FrenchRepublicanRomme, Gregorian.ToJD(1795, 9, 22), 4, 1, 1},
+		{"18 Brumaire VIII", FrenchRepublican, Gregorian.ToJD(1799, 11, 9), 8, 2, 18},
+		{"1 Vendémiaire XII", FrenchRepublican, Gregorian.ToJD(1803, 9, 24), 12, 1, 1},
+		{"10 Nivôse XIV", FrenchRepublican, Gregorian.ToJD(1805, 12, 31), 14, 4, 10},
+		{"Commune", FrenchRepublican, Gregorian.ToJD(1871, 5, 6), 79, 8, 16},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.cal.ToJD(tt.year, tt.month, tt.day); got != tt.jd {
+				t.Errorf("ToJD(%v, %v, %v) = %v, want %v", tt.year, tt.month, tt.day, got, tt.jd)
+			}
+			y, m, d := tt.cal.FromJD(tt.jd + 0.5)
+			if y != tt.year || m != tt.month || d != tt.day {
+				t.Errorf("FromJD(%v) = %v, %v, %v, want %v, %v, %v", tt.jd+0.5, y, m, d, tt.year, tt.month, tt.day)
+			}
+		})
+	}
+}
+
+func TestFrenchRepublican_leap(t *testing.T) {
+	// the sextile years of the equinox rule while the calendar was in use
+	for y := 1; y <= 14; y++ {
+		want := y == 3 || y == 7 || y == 11
+		if got := FrenchRepublican.IsLeapYear(y); got != want {
+			t.Errorf("FrenchRepublican.IsLeapYear(%v) = %v, want %v", y, got, want)
+		}
+	}
+	for _, y := range []int{4, 8, 400, 4400} {
+		if !FrenchRepublicanRomme.IsLeapYear(y) {
+			t.Errorf("FrenchRepublicanRomme.IsLeapYear(%v) = false", y)
+		}
+	}
+	for _, y := range []int{3, 100, 4000} {
+		if FrenchRepublicanRomme.IsLeapYear(y) {
+			t.Errorf("FrenchRepublicanRomme.IsLeapYear(%v) = true", y)
+		}
+	}
+}
+
+func TestFrenchRepublican_roundTrip(t *testing.T) {
+	for _, cal := range []FrenchRepublicanCalendar{FrenchRepublican, FrenchRepublicanRomme} {
+		for jd := julian.Date(2_300_000.5); jd < 2_800_000; jd += 97 {
+			y, m, d := cal.FromJD(jd)
+			if got := cal.ToJD(y, m, d); got != jd {
+				t.Fatalf("%+v.ToJD(FromJD(%v)) = %v (%v, %v, %v)", cal, jd, got, y, m, d)
+			}
+			if m < 1 || m > 13 || d < 1 || d > cal.DaysInMonth(y, m) {
+				t.Fatalf("%+v.FromJD(%v) = %v, %v, %v, out of range", cal, jd, y, m, d)
+			}
+		}
+	}
+}
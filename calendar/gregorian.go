@@ -0,0 +1,95 @@
+package calendar
+
+import (
+	"time"
+
+	"github.com/pachecot/julian"
+)
+
+// GregorianCalendar is the proleptic Gregorian calendar. Years are numbered
+// astronomically, so the year 1 BC is 0.
+type GregorianCalendar struct{}
+
+// Gregorian is the proleptic Gregorian calendar.
+var Gregorian GregorianCalendar
+
+// IsLeapYear reports whether year is a leap year in the Gregorian calendar.
+func (GregorianCalendar) IsLeapYear(year int) bool {
+	return year%4 == 0 && (year%100 != 0 || year%400 == 0)
+}
+
+// ToJD returns the julian date of the midnight UTC that starts the given
+// Gregorian calendar date. Months and days outside their usual ranges are
+// counted on from the start of the year and month.
+func (c GregorianCalendar) ToJD(year, month, day int) julian.Date {
+	return jdFromFixed(c.fixed(year, month, day))
+}
+
+// FromJD returns the Gregorian calendar date of the UTC day containing jd.
+func (c GregorianCalendar) FromJD(jd julian.Date) (year, month, day int) {
+	return c.fromFixed(fixedFromJD(jd))
+}
+
+func (c GregorianCalendar) fixed(year, month, day int) int64 {
+	y := int64(year) + floorDiv(int64(month-1), 12)
+	m := floorMod(int64(month-1), 12) + 1
+	f := 365*(y-1) + floorDiv(y-1, 4) - floorDiv(y-1, 100) + floorDiv(y-1, 400) + (367*m-362)/12 + int64(day)
+	switch {
+	case m <= 2:
+	case c.IsLeapYear(int(y)):
+		f--
+	default:
+		f -= 2
+	}
+	return f
+}
+
+// year returns the Gregorian year containing the fixed day f.
+func (GregorianCalendar) year(f int64) int {
+	d0 := f - 1
+	n400, d1 := floorDiv(d0, 146097), floorMod(d0, 146097)
+	n100, d2 := d1/36524, d1%36524
+	n4, d3 := d2/1461, d2%1461
+	n1 := d3 / 365
+	year := 400*n400 + 100*n100 + 4*n4 + n1
+	if n100 == 4 || n1 == 4 {
+		return int(year)
+	}
+	return int(year + 1)
+}
+
+func (c GregorianCalendar) fromFixed(f int64) (year, month, day int) {
+	year = c.year(f)
+	prior := f - c.fixed(year, 1, 1)
+	var correction int64
+	if f >= c.fixed(year, 3, 1) {
+		correction = 2
+		if c.IsLeapYear(year) {
+			correction = 1
+		}
+	}
+	month = int(floorDiv(12*(prior+correction)+373, 367))
+	day = int(f-c.fixed(year, month, 1)) + 1
+	return year, month, day
+}
+
+// Name returns the name of the calendar, "Gregorian".
+func (GregorianCalendar) Name() string { return "Gregorian" }
+
+// ToJDN returns the julian day number of the date.
+func (c GregorianCalendar) ToJDN(f Fields) int64 { return toJDN(c.fixed(f.Year, f.Month, f.Day)) }
+
+// FromJDN returns the date of the day with the julian day number jdn.
+func (c GregorianCalendar) FromJDN(jdn int64) Fields {
+	y, m, d := c.fromFixed(fromJDN(jdn))
+	return Fields{y, m, d}
+}
+
+// MonthsInYear returns 12.
+func (GregorianCalendar) MonthsInYear(year int) int { return 12 }
+
+// MonthName returns the English name of the month, e.g. "March".
+func (GregorianCalendar) MonthName(year, month int) string { return time.Month(month).String() }
+
+// Era returns "AD". Years before AD 1 are numbered 0, -1, and so on.
+func (GregorianCalendar) Era() string { return "AD" }
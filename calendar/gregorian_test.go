@@ -0,0 +1,23 @@
+package calendar
+
+import (
+	"testing"
+
+	"github.com/pachecot/julian"
+)
+
+func TestGregorian(t *testing.T) {
+	for jd := julian.Date(-800_000.5); jd < 4_000_000; jd += 997 {
+		y, m, d := Gregorian.FromJD(jd)
+		wy, wm, wd := jd.Date()
+		if y != wy || m != int(wm) || d != wd {
+			t.Fatalf("Gregorian.FromJD(%v) = %v, %v, %v, want %v, %v, %v", jd, y, m, d, wy, int(wm), wd)
+		}
+		if got := Gregorian.ToJD(y, m, d); got != jd {
+			t.Fatalf("Gregorian.ToJD(%v, %v, %v) = %v, want %v", y, m, d, got, jd)
+		}
+	}
+	if got := Gregorian.ToJD(2000, 1, 1); got != 2_451_544.5 {
+		t.Errorf("Gregorian.ToJD(2000, 1, 1) = %v, want 2451544.5", got)
+	}
+}
@@ -0,0 +1,169 @@
+package calendar
+
+import "github.com/pachecot/julian"
+
+// HebrewCalendar is the arithmetic Hebrew calendar, with years counted from
+// the creation epoch (Anno Mundi). Months are numbered from Nisan, as in the
+// Bible, so Nisan is 1, Tishri, which starts the year, is 7, Adar is 12 and
+// the leap month Adar II is 13. In a leap year Adar (12) is Adar I.
+type HebrewCalendar struct{}
+
+// Hebrew is the arithmetic Hebrew calendar.
+var Hebrew HebrewCalendar
+
+const (
+	hebrew_epoch = -1_373_427 // fixed day of 1 Tishri AM 1, October 7, 3761 BC (Julian)
+	tishri       = 7
+	nisan        = 1
+	adar         = 12
+)
+
+// IsLeapYear reports whether year has the leap month Adar II, which the
+// 19-year Metonic cycle adds in years 3, 6, 8, 11, 14, 17 and 19.
+func (HebrewCalendar) IsLeapYear(year int) bool {
+	return floorMod(7*int64(year)+1, 19) < 7
+}
+
+// MonthsInYear returns the number of months in year, 12 or 13.
+func (c HebrewCalendar) MonthsInYear(year int) int {
+	if c.IsLeapYear(year) {
+		return 13
+	}
+	return 12
+}
+
+// DaysInYear returns the number of days in year: 353, 354 or 355 in a
+// common year and 383, 384 or 385 in a leap year.
+func (c HebrewCalendar) DaysInYear(year int) int {
+	return int(c.newYear(year+1) - c.newYear(year))
+}
+
+// DaysInMonth returns the number of days in the given month of year, 29 or
+// 30. The lengths of Heshvan (8) and Kislev (9) vary with the length of the
+// year.
+func (c HebrewCalendar) DaysInMonth(year, month int) int {
+	switch month {
+	case 2, 4, 6, 10, 13:
+		return 29
+	case adar:
+		if !c.IsLeapYear(year) {
+			return 29
+		}
+	case 8:
+		if c.DaysInYear(year)%10 != 5 {
+			return 29
+		}
+	case 9:
+		if c.DaysInYear(year)%10 == 3 {
+			return 29
+		}
+	}
+	return 30
+}
+
+// ToJD returns the julian date of the midnight UTC that starts the given
+// Hebrew date. The month must be in the range [1, MonthsInYear(year)]; days
+// outside the month are counted on from its first day. The Hebrew day
+// begins at the preceding sunset, which ToJD does not model.
+func (c HebrewCalendar) ToJD(year, month, day int) julian.Date {
+	return jdFromFixed(c.fixed(year, month, day))
+}
+
+// FromJD returns the Hebrew date of the UTC day containing jd.
+func (c HebrewCalendar) FromJD(jd julian.Date) (year, month, day int) {
+	return c.fromFixed(fixedFromJD(jd))
+}
+
+func (c HebrewCalendar) fixed(year, month, day int) int64 {
+	f := c.newYear(year) + int64(day) - 1
+	if month < tishri {
+		for m := tishri; m <= c.MonthsInYear(year); m++ {
+			f += int64(c.DaysInMonth(year, m))
+		}
+		for m := nisan; m < month; m++ {
+			f += int64(c.DaysInMonth(year, m))
+		}
+	} else {
+		for m := tishri; m < month; m++ {
+			f += int64(c.DaysInMonth(year, m))
+		}
+	}
+	return f
+}
+
+func (c HebrewCalendar) fromFixed(f int64) (year, month, day int) {
+	// the mean year is 35975351/98496 days; the estimate may fall short by
+	// up to two years but never overshoots
+	year = int(floorDiv((f-hebrew_epoch)*98496, 35_975_351))
+	for c.newYear(year+1) <= f {
+		year++
+	}
+	month = tishri
+	if f >= c.fixed(year, nisan, 1) {
+		month = nisan
+	}
+	for f >= c.fixed(year, month, 1)+int64(c.DaysInMonth(year, month)) {
+		month++
+	}
+	return year, month, int(f-c.fixed(year, month, 1)) + 1
+}
+
+// newYear returns the fixed day of 1 Tishri of year.
+func (c HebrewCalendar) newYear(year int) int64 {
+	return hebrew_epoch + c.elapsedDays(year) + c.yearLengthCorrection(year)
+}
+
+// elapsedDays returns the days from the epoch to the molad of Tishri of
+// year, postponed by a day if that would put the new year on a Sunday,
+// Wednesday or Friday.
+func (HebrewCalendar) elapsedDays(year int) int64 {
+	months := floorDiv(235*int64(year)-234, 19)
+	parts := 12084 + 13753*months
+	days := 29*months + floorDiv(parts, 25920)
+	if floorMod(3*(days+1), 7) < 3 {
+		return days + 1
+	}
+	return days
+}
+
+// yearLengthCorrection returns the further postponement of the new year
+// that keeps every year between 353 and 385 days long.
+func (c HebrewCalendar) yearLengthCorrection(year int) int64 {
+	ny0, ny1, ny2 := c.elapsedDays(year-1), c.elapsedDays(year), c.elapsedDays(year+1)
+	switch {
+	case ny2-ny1 == 356:
+		return 2
+	case ny1-ny0 == 382:
+		return 1
+	}
+	return 0
+}
+
+// Name returns the name of the calendar, "Hebrew".
+func (HebrewCalendar) Name() string { return "Hebrew" }
+
+// ToJDN returns the julian day number of the date.
+func (c HebrewCalendar) ToJDN(f Fields) int64 { return toJDN(c.fixed(f.Year, f.Month, f.Day)) }
+
+// FromJDN returns the date of the day with the julian day number jdn.
+func (c HebrewCalendar) FromJDN(jdn int64) Fields {
+	y, m, d := c.fromFixed(fromJDN(jdn))
+	return Fields{y, m, d}
+}
+
+// MonthName returns the name of the month, e.g. "Tishri". In a leap year
+// Adar is "Adar I".
+func (c HebrewCalendar) MonthName(year, month int) string {
+	if month == adar && c.IsLeapYear(year) {
+		return "Adar I"
+	}
+	return monthName(hebrewMonths[:], month)
+}
+
+var hebrewMonths = [...]string{
+	"Nisan", "Iyyar", "Sivan", "Tammuz", "Av", "Elul",
+	"Tishri", "Heshvan", "Kislev", "Tevet", "Shevat", "Adar", "Adar II",
+}
+
+// Era returns "AM", Anno Mundi.
+func (HebrewCalendar) Era() string { return "AM" }
@@ -0,0 +1,80 @@
+package calendar
+
+import (
+	"testing"
+
+	"github.com/pachecot/julian"
+)
+
+func TestHebrew(t *testing.T) {
+	tests := []struct {
+		name             string
+		jd               julian.Date
+		year, month, day int
+	}{
+		{"epoch", Julian.ToJD(-3760, 10, 7), 1, 7, 1},
+		{"586 BC", jdFromFixed(-214_193), 3174, 5, 10},
+		{"1945", Gregorian.ToJD(1945, 11, 12), 5706, 9, 7},
+		{"Purim 5783", Gregorian.ToJD(2023, 3, 7), 5783, 12, 14},
+		{"Rosh Hashanah 5784", Gregorian.ToJD(2023, 9, 16), 5784, 7, 1},
+		{"Purim 5784", Gregorian.ToJD(2024, 3, 24), 5784, 13, 14},
+		{"Passover 5784", Gregorian.ToJD(2024, 4, 23), 5784, 1, 15},
+		{"Rosh Hashanah 5785", Gregorian.ToJD(2024, 10, 3), 5785, 7, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Hebrew.ToJD(tt.year, tt.month, tt.day); got != tt.jd {
+				t.Errorf("Hebrew.ToJD(%v, %v, %v) = %v, want %v", tt.year, tt.month, tt.day, got, tt.jd)
+			}
+			y, m, d := Hebrew.FromJD(tt.jd + 0.5)
+			if y != tt.year || m != tt.month || d != tt.day {
+				t.Errorf("Hebrew.FromJD(%v) = %v, %v, %v, want %v, %v, %v", tt.jd+0.5, y, m, d, tt.year, tt.month, tt.day)
+			}
+		})
+	}
+}
+
+func TestHebrew_year(t *testing.T) {
+	tests := []struct {
+		year   int
+		leap   bool
+		days   int
+		months int
+	}{
+		{5783, false, 355, 12},
+		{5784, true, 383, 13},
+		{5785, false, 355, 12},
+		{5786, false, 354, 12},
+		{5787, true, 385, 13},
+	}
+	for _, tt := range tests {
+		if got := Hebrew.IsLeapYear(tt.year); got != tt.leap {
+			t.Errorf("Hebrew.IsLeapYear(%v) = %v, want %v", tt.year, got, tt.leap)
+		}
+		if got := Hebrew.DaysInYear(tt.year); got != tt.days {
+			t.Errorf("Hebrew.DaysInYear(%v) = %v, want %v", tt.year, got, tt.days)
+		}
+		if got := Hebrew.MonthsInYear(tt.year); got != tt.months {
+			t.Errorf("Hebrew.MonthsInYear(%v) = %v, want %v", tt.year, got, tt.months)
+		}
+		sum := 0
+		for m := 1; m <= tt.months; m++ {
+			sum += Hebrew.DaysInMonth(tt.year, m)
+		}
+		if sum != tt.days {
+			t.Errorf("sum of Hebrew.DaysInMonth(%v) = %v, want %v", tt.year, sum, tt.days)
+		}
+	}
+}
+
+func TestHebrew_roundTrip(t *testing.T) {
+	for jd := julian.Date(-800_000.5); jd < 4_000_000; jd += 997 {
+		y, m, d := Hebrew.FromJD(jd)
+		if got := Hebrew.ToJD(y, m, d); got != jd {
+			t.Fatalf("Hebrew.ToJD(Hebrew.FromJD(%v)) = %v (%v, %v, %v)", jd, got, y, m, d)
+		}
+		if d < 1 || d > Hebrew.DaysInMonth(y, m) {
+			t.Fatalf("Hebrew.FromJD(%v) = %v, %v, %v, day out of range", jd, y, m, d)
+		}
+	}
+}
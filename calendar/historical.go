@@ -0,0 +1,109 @@
+package calendar
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pachecot/julian"
+)
+
+// A HistoricalCalendar is the Julian calendar up to a switchover and the
+// Gregorian calendar from then on, as used in a given country. The dates
+// skipped at the switchover do not exist in the calendar.
+type HistoricalCalendar struct {
+	// Switch is the julian date of the midnight that starts the first
+	// Gregorian day.
+	Switch julian.Date
+
+	// LadyDayUntil is the julian date of the midnight from which the civil
+	// year began on January 1 instead of Lady Day, March 25, as used by
+	// DualDate. It is zero where the year began on January 1 throughout.
+	LadyDayUntil julian.Date
+}
+
+var (
+	// Papal is the switchover of the papal bull Inter gravissimas, adopted
+	// by Italy, Spain, Portugal and Poland: Thursday, October 4, 1582
+	// (Julian) was followed by Friday, October 15, 1582 (Gregorian).
+	Papal = HistoricalCalendar{Switch: 2_299_160.5}
+
+	// British is the switchover of Great Britain and its colonies:
+	// September 2, 1752 (Julian) was followed by September 14, 1752. The
+	// Calendar Act of 1750 also moved the start of the year from March 25
+	// to January 1, from January 1, 1752.
+	British = HistoricalCalendar{Switch: 2_361_221.5, LadyDayUntil: 2_360_975.5}
+
+	// Russian is the switchover of Soviet Russia: January 31, 1918 (Julian)
+	// was followed by February 14, 1918.
+	Russian = HistoricalCalendar{Switch: 2_421_638.5}
+)
+
+// IsLeapYear reports whether year is a leap year in the calendar in force at
+// the end of February of that year.
+func (c HistoricalCalendar) IsLeapYear(year int) bool {
+	if Gregorian.ToJD(year, 3, 1) >= c.Switch {
+		return Gregorian.IsLeapYear(year)
+	}
+	return Julian.IsLeapYear(year)
+}
+
+// ToJD returns the julian date of the midnight UTC that starts the given
+// date. A date before the switchover is read in the Julian calendar and one
+// after it in the Gregorian. A date skipped by the switchover is read in the
+// Julian calendar, so with the Papal switchover October 10, 1582 is the same
+// day as October 20; use IsValid to reject such dates.
+func (c HistoricalCalendar) ToJD(year, month, day int) julian.Date {
+	if jd := Gregorian.ToJD(year, month, day); jd >= c.Switch {
+		return jd
+	}
+	return Julian.ToJD(year, month, day)
+}
+
+// FromJD returns the date of the UTC day containing jd in the calendar in
+// force on that day.
+func (c HistoricalCalendar) FromJD(jd julian.Date) (year, month, day int) {
+	if c.IsGregorian(jd) {
+		return Gregorian.FromJD(jd)
+	}
+	return Julian.FromJD(jd)
+}
+
+// IsValid reports whether the date exists in the calendar, that is, its
+// month and day are in range and it was not skipped by the switchover.
+func (c HistoricalCalendar) IsValid(year, month, day int) bool {
+	y, m, d := c.FromJD(c.ToJD(year, month, day))
+	return y == year && m == month && d == day
+}
+
+// IsGregorian reports whether the Gregorian calendar is in force on the UTC
+// day containing jd.
+func (c HistoricalCalendar) IsGregorian(jd julian.Date) bool {
+	return jdFromFixed(fixedFromJD(jd)) >= c.Switch
+}
+
+// Name returns the name of the calendar, "Julian/Gregorian" followed by the
+// first Gregorian date, e.g. "Julian/Gregorian 1582-10-15".
+func (c HistoricalCalendar) Name() string {
+	y, m, d := Gregorian.FromJD(c.Switch)
+	return fmt.Sprintf("Julian/Gregorian %04d-%02d-%02d", y, m, d)
+}
+
+// ToJDN returns the julian day number of the date.
+func (c HistoricalCalendar) ToJDN(f Fields) int64 {
+	return toJDN(fixedFromJD(c.ToJD(f.Year, f.Month, f.Day)))
+}
+
+// FromJDN returns the date of the day with the julian day number jdn.
+func (c HistoricalCalendar) FromJDN(jdn int64) Fields {
+	y, m, d := c.FromJD(jdFromFixed(fromJDN(jdn)))
+	return Fields{y, m, d}
+}
+
+// MonthsInYear returns 12.
+func (HistoricalCalendar) MonthsInYear(year int) int { return 12 }
+
+// MonthName returns the English name of the month, e.g. "March".
+func (HistoricalCalendar) MonthName(year, month int) string { return time.Month(month).String() }
+
+// Era returns "AD". Years before AD 1 are numbered 0, -1, and so on.
+func (HistoricalCalendar) Era() string { return "AD" }
@@ -0,0 +1,60 @@
+package calendar
+
+import (
+	"testing"
+
+	"github.com/pachecot/julian"
+)
+
+func TestHistoricalCalendar(t *testing.T) {
+	tests := []struct {
+		name             string
+		cal              HistoricalCalendar
+		jd               julian.Date
+		year, month, day int
+	}{
+		{"papal last Julian", Papal, 2_299_159.5, 1582, 10, 4},
+		{"papal first Gregorian", Papal, 2_299_160.5, 1582, 10, 15},
+		{"british last Julian", British, 2_361_220.5, 1752, 9, 2},
+		{"british first Gregorian", British, 2_361_221.5, 1752, 9, 14},
+		{"british 1700", British, Julian.ToJD(1700, 2, 29), 1700, 2, 29},
+		{"russian last Julian", Russian, 2_421_637.5, 1918, 1, 31},
+		{"russian first Gregorian", Russian, 2_421_638.5, 1918, 2, 14},
+		{"papal ancient", Papal, -0.5, -4712, 1, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.cal.ToJD(tt.year, tt.month, tt.day); got != tt.jd {
+				t.Errorf("ToJD(%v, %v, %v) = %v, want %v", tt.year, tt.month, tt.day, got, tt.jd)
+			}
+			y, m, d := tt.cal.FromJD(tt.jd + 0.25)
+			if y != tt.year || m != tt.month || d != tt.day {
+				t.Errorf("FromJD(%v) = %v, %v, %v, want %v, %v, %v", tt.jd+0.25, y, m, d, tt.year, tt.month, tt.day)
+			}
+			if !tt.cal.IsValid(tt.year, tt.month, tt.day) {
+				t.Errorf("IsValid(%v, %v, %v) = false", tt.year, tt.month, tt.day)
+			}
+		})
+	}
+}
+
+func TestHistoricalCalendar_gap(t *testing.T) {
+	if Papal.IsValid(1582, 10, 10) {
+		t.Error("Papal.IsValid(1582, 10, 10) = true, want false")
+	}
+	if got, want := Papal.ToJD(1582, 10, 10), Papal.ToJD(1582, 10, 20); got != want {
+		t.Errorf("Papal.ToJD(1582, 10, 10) = %v, want %v", got, want)
+	}
+	if !British.IsValid(1582, 10, 10) {
+		t.Error("British.IsValid(1582, 10, 10) = false, want true")
+	}
+	if Papal.IsLeapYear(1700) || !British.IsLeapYear(1700) || British.IsLeapYear(1800) {
+		t.Error("IsLeapYear(1700/1800) disagrees with the calendar in force")
+	}
+}
+
+func TestBritish_ladyDayUntil(t *testing.T) {
+	if want := Julian.ToJD(1752, 1, 1); British.LadyDayUntil != want {
+		t.Errorf("British.LadyDayUntil = %v, want %v", British.LadyDayUntil, want)
+	}
+}
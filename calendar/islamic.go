@@ -0,0 +1,107 @@
+package calendar
+
+import "github.com/pachecot/julian"
+
+// An IslamicCalendar is the tabular Islamic (Hijri) calendar, which
+// approximates the observed lunar months with alternating 30- and 29-day
+// months and adds a day to the last month in 11 years of each 30. The
+// calendar is counted from one of two epochs.
+type IslamicCalendar struct {
+	// Astronomical selects the astronomical epoch, Thursday, July 15, 622
+	// (Julian), in place of the civil epoch a day later.
+	Astronomical bool
+}
+
+var (
+	// IslamicCivil is the tabular Islamic calendar with the civil epoch,
+	// Friday, July 16, 622 (Julian).
+	IslamicCivil = IslamicCalendar{}
+
+	// IslamicAstronomical is the tabular Islamic calendar with the
+	// astronomical epoch, Thursday, July 15, 622 (Julian).
+	IslamicAstronomical = IslamicCalendar{Astronomical: true}
+)
+
+// islamic_epoch is the fixed day of 1 Muharram AH 1 in the civil calendar.
+const islamic_epoch = 227_015
+
+func (c IslamicCalendar) epoch() int64 {
+	if c.Astronomical {
+		return islamic_epoch - 1
+	}
+	return islamic_epoch
+}
+
+// IsLeapYear reports whether year has 355 days, the years 2, 5, 7, 10, 13,
+// 16, 18, 21, 24, 26 and 29 of each 30-year cycle.
+func (IslamicCalendar) IsLeapYear(year int) bool {
+	return floorMod(14+11*int64(year), 30) < 11
+}
+
+// DaysInMonth returns the number of days in the given month of year: 30 in
+// odd months, 29 in even months, and 30 in Dhu al-Hijja (12) of a leap year.
+func (c IslamicCalendar) DaysInMonth(year, month int) int {
+	if month%2 == 1 || month == 12 && c.IsLeapYear(year) {
+		return 30
+	}
+	return 29
+}
+
+// ToJD returns the julian date of the midnight UTC that starts the given
+// Islamic date. The month must be in the range [1, 12]; days outside the
+// month are counted on from its first day. The Islamic day begins at the
+// preceding sunset, which ToJD does not model.
+func (c IslamicCalendar) ToJD(year, month, day int) julian.Date {
+	return jdFromFixed(c.fixed(year, month, day))
+}
+
+// FromJD returns the Islamic date of the UTC day containing jd.
+func (c IslamicCalendar) FromJD(jd julian.Date) (year, month, day int) {
+	return c.fromFixed(fixedFromJD(jd))
+}
+
+func (c IslamicCalendar) fixed(year, month, day int) int64 {
+	y, m := int64(year), int64(month)
+	return c.epoch() - 1 + (y-1)*354 + floorDiv(3+11*y, 30) + 29*(m-1) + m/2 + int64(day)
+}
+
+func (c IslamicCalendar) fromFixed(f int64) (year, month, day int) {
+	year = int(floorDiv(30*(f-c.epoch())+10646, 10631))
+	prior := f - c.fixed(year, 1, 1)
+	month = int(floorDiv(11*prior+330, 325))
+	return year, month, int(f-c.fixed(year, month, 1)) + 1
+}
+
+// Name returns the name of the calendar, "Islamic" or "Islamic
+// (astronomical)".
+func (c IslamicCalendar) Name() string {
+	if c.Astronomical {
+		return "Islamic (astronomical)"
+	}
+	return "Islamic"
+}
+
+// ToJDN returns the julian day number of the date.
+func (c IslamicCalendar) ToJDN(f Fields) int64 { return toJDN(c.fixed(f.Year, f.Month, f.Day)) }
+
+// FromJDN returns the date of the day with the julian day number jdn.
+func (c IslamicCalendar) FromJDN(jdn int64) Fields {
+	y, m, d := c.fromFixed(fromJDN(jdn))
+	return Fields{y, m, d}
+}
+
+// MonthsInYear returns 12.
+func (IslamicCalendar) MonthsInYear(year int) int { return 12 }
+
+// MonthName returns the transliterated name of the month, e.g. "Ramadan".
+func (IslamicCalendar) MonthName(year, month int) string {
+	return monthName(islamicMonths[:], month)
+}
+
+var islamicMonths = [...]string{
+	"Muharram", "Safar", "Rabi' al-awwal", "Rabi' al-thani", "Jumada al-awwal", "Jumada al-thani",
+	"Rajab", "Sha'ban", "Ramadan", "Shawwal", "Dhu al-Qi'dah", "Dhu al-Hijjah",
+}
+
+// Era returns "AH", Anno Hegirae.
+func (IslamicCalendar) Era() string { return "AH" }
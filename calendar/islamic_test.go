@@ -0,0 +1,61 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pachecot/julian"
+)
+
+func TestIslamic(t *testing.T) {
+	tests := []struct {
+		name             string
+		cal              IslamicCalendar
+		jd               julian.Date
+		year, month, day int
+	}{
+		{"civil epoch", IslamicCivil, 1_948_439.5, 1, 1, 1},
+		{"astronomical epoch", IslamicAstronomical, 1_948_438.5, 1, 1, 1},
+		{"586 BC", IslamicCivil, jdFromFixed(-214_193), -1245, 12, 9},
+		{"1945", IslamicCivil, Gregorian.ToJD(1945, 11, 12), 1364, 12, 6},
+		{"1400 AH", IslamicCivil, Gregorian.ToJD(1979, 11, 21), 1400, 1, 1},
+		{"1400 AH astronomical", IslamicAstronomical, Gregorian.ToJD(1979, 11, 20), 1400, 1, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.cal.ToJD(tt.year, tt.month, tt.day); got != tt.jd {
+				t.Errorf("ToJD(%v, %v, %v) = %v, want %v", tt.year, tt.month, tt.day, got, tt.jd)
+			}
+			y, m, d := tt.cal.FromJD(tt.jd + 0.5)
+			if y != tt.year || m != tt.month || d != tt.day {
+				t.Errorf("FromJD(%v) = %v, %v, %v, want %v, %v, %v", tt.jd+0.5, y, m, d, tt.year, tt.month, tt.day)
+			}
+		})
+	}
+	if got := Julian.ToJD(622, 7, 16).Weekday(); got != time.Friday {
+		t.Errorf("civil epoch weekday = %v, want Friday", got)
+	}
+}
+
+func TestIslamic_roundTrip(t *testing.T) {
+	for _, cal := range []IslamicCalendar{IslamicCivil, IslamicAstronomical} {
+		for jd := julian.Date(-800_000.5); jd < 4_000_000; jd += 997 {
+			y, m, d := cal.FromJD(jd)
+			if got := cal.ToJD(y, m, d); got != jd {
+				t.Fatalf("ToJD(FromJD(%v)) = %v (%v, %v, %v)", jd, got, y, m, d)
+			}
+			if m < 1 || m > 12 || d < 1 || d > cal.DaysInMonth(y, m) {
+				t.Fatalf("FromJD(%v) = %v, %v, %v, out of range", jd, y, m, d)
+			}
+		}
+	}
+	days := 0
+	for y := 1; y <= 30; y++ {
+		for m := 1; m <= 12; m++ {
+			days += IslamicCivil.DaysInMonth(y, m)
+		}
+	}
+	if days != 10631 {
+		t.Errorf("days in a 30-year cycle = %v, want 10631", days)
+	}
+}
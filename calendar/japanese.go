@@ -0,0 +1,109 @@
+package calendar
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pachecot/julian"
+)
+
+// A JapaneseEra is a regnal era (nengō) of the Japanese calendar.
+type JapaneseEra struct {
+	Name  string      // the romanized name, e.g. "Shōwa"
+	Kanji string      // the name in kanji, e.g. "昭和"
+	Abbr  string      // the one-letter abbreviation, e.g. "S"
+	Start julian.Date // the midnight UTC that starts the first day of the era
+}
+
+// A JapaneseCalendar is the Gregorian calendar with years counted in regnal
+// eras. The first year of an era (gannen) is the Gregorian year in which it
+// starts, and runs only from its first day; later years start on January 1.
+type JapaneseCalendar struct {
+	// Eras are the eras in increasing order of Start.
+	Eras []JapaneseEra
+}
+
+// JapaneseEras are the eras of the modern calendar. Taking Meiji from its
+// proclamation, the dates before 1873, when Japan adopted the Gregorian
+// calendar, are given in it proleptically, not in the lunisolar calendar
+// then in use.
+var JapaneseEras = []JapaneseEra{
+	{"Meiji", "明治", "M", 2_403_628.5},
+	{"Taishō", "大正", "T", 2_419_613.5},
+	{"Shōwa", "昭和", "S", 2_424_874.5},
+	{"Heisei", "平成", "H", 2_447_534.5},
+	{"Reiwa", "令和", "R", 2_458_604.5},
+}
+
+// Japanese is the Japanese calendar with the eras of JapaneseEras. To add an
+// era, append it to a copy of JapaneseEras in a calendar of your own.
+var Japanese = JapaneseCalendar{JapaneseEras}
+
+// A JapaneseDate is a date of the Japanese calendar.
+type JapaneseDate struct {
+	Era              JapaneseEra
+	Year, Month, Day int
+}
+
+// String returns the date with the romanized era name, e.g. "Reiwa 6-03-20".
+func (d JapaneseDate) String() string {
+	return fmt.Sprintf("%s %d-%02d-%02d", d.Era.Name, d.Year, d.Month, d.Day)
+}
+
+// Kanji returns the date in the Japanese style, e.g. "令和6年3月20日", with
+// the first year of an era written 元年.
+func (d JapaneseDate) Kanji() string {
+	year := strconv.Itoa(d.Year)
+	if d.Year == 1 {
+		year = "元"
+	}
+	return fmt.Sprintf("%s%s年%d月%d日", d.Era.Kanji, year, d.Month, d.Day)
+}
+
+// era returns the index of the era named by its romanized name, with or
+// without macrons and in any case, its kanji or its abbreviation.
+func (c JapaneseCalendar) era(name string) int {
+	name = strings.ToLower(removeMacrons.Replace(name))
+	for i, e := range c.Eras {
+		if name == strings.ToLower(removeMacrons.Replace(e.Name)) || name == e.Kanji || name == strings.ToLower(e.Abbr) {
+			return i
+		}
+	}
+	return -1
+}
+
+var removeMacrons = strings.NewReplacer("ō", "o", "Ō", "O", "ū", "u", "Ū", "U")
+
+// ToJD returns the julian date of the midnight UTC that starts the given day
+// of the named era. It returns ErrSyntax if the era is unknown and ErrRange
+// if the date is not within the era.
+func (c JapaneseCalendar) ToJD(era string, year, month, day int) (julian.Date, error) {
+	i := c.era(era)
+	if i < 0 {
+		return 0, fmt.Errorf("%w: unknown era %q", julian.ErrSyntax, era)
+	}
+	e := c.Eras[i]
+	start, _, _ := Gregorian.FromJD(e.Start)
+	jd := Gregorian.ToJD(start+year-1, month, day)
+	if y, m, d := Gregorian.FromJD(jd); year < 1 || m != month || d != day || y != start+year-1 ||
+		jd < e.Start || i+1 < len(c.Eras) && jd >= c.Eras[i+1].Start {
+		return 0, fmt.Errorf("%w: %s %d-%02d-%02d", julian.ErrRange, e.Name, year, month, day)
+	}
+	return jd, nil
+}
+
+// FromJD returns the Japanese date of the UTC day containing jd. It returns
+// ErrRange if jd is before the first era.
+func (c JapaneseCalendar) FromJD(jd julian.Date) (JapaneseDate, error) {
+	day := jdFromFixed(fixedFromJD(jd))
+	for i := len(c.Eras) - 1; i >= 0; i-- {
+		e := c.Eras[i]
+		if day >= e.Start {
+			start, _, _ := Gregorian.FromJD(e.Start)
+			y, m, d := Gregorian.FromJD(day)
+			return JapaneseDate{e, y - start + 1, m, d}, nil
+		}
+	}
+	return JapaneseDate{}, fmt.Errorf("%w: before the first era", julian.ErrRange)
+}
@@ -0,0 +1,106 @@
+package calendar
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/pachecot/julian"
+)
+
+func TestJapanese(t *testing.T) {
+	tests := []struct {
+		name             string
+		jd               julian.Date
+		era              string
+		year, month, day int
+		str, kanji       string
+	}{
+		{"Meiji", Gregorian.ToJD(1868, 10, 23), "Meiji", 1, 10, 23, "Meiji 1-10-23", "明治元年10月23日"},
+		{"last of Meiji", Gregorian.ToJD(1912, 7, 29), "Meiji", 45, 7, 29, "Meiji 45-07-29", "明治45年7月29日"},
+		{"Taishō", Gregorian.ToJD(1912, 7, 30), "Taishō", 1, 7, 30, "Taishō 1-07-30", "大正元年7月30日"},
+		{"Shōwa", Gregorian.ToJD(1926, 12, 25), "Shōwa", 1, 12, 25, "Shōwa 1-12-25", "昭和元年12月25日"},
+		{"Shōwa 2", Gregorian.ToJD(1927, 1, 1), "Shōwa", 2, 1, 1, "Shōwa 2-01-01", "昭和2年1月1日"},
+		{"last of Shōwa", Gregorian.ToJD(1989, 1, 7), "Shōwa", 64, 1, 7, "Shōwa 64-01-07", "昭和64年1月7日"},
+		{"Heisei", Gregorian.ToJD(1989, 1, 8), "Heisei", 1, 1, 8, "Heisei 1-01-08", "平成元年1月8日"},
+		{"last of Heisei", Gregorian.ToJD(2019, 4, 30), "Heisei", 31, 4, 30, "Heisei 31-04-30", "平成31年4月30日"},
+		{"Reiwa", Gregorian.ToJD(2019, 5, 1), "Reiwa", 1, 5, 1, "Reiwa 1-05-01", "令和元年5月1日"},
+		{"Reiwa 6", Gregorian.ToJD(2024, 3, 20), "Reiwa", 6, 3, 20, "Reiwa 6-03-20", "令和6年3月20日"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Japanese.ToJD(tt.era, tt.year, tt.month, tt.day)
+			if err != nil || got != tt.jd {
+				t.Errorf("Japanese.ToJD(%q, %v, %v, %v) = %v, %v, want %v", tt.era, tt.year, tt.month, tt.day, got, err, tt.jd)
+			}
+			d, err := Japanese.FromJD(tt.jd + 0.5)
+			if err != nil || d.Era.Name != tt.era || d.Year != tt.year || d.Month != tt.month || d.Day != tt.day {
+				t.Errorf("Japanese.FromJD(%v) = %v, %v, want %v %v-%v-%v", tt.jd+0.5, d, err, tt.era, tt.year, tt.month, tt.day)
+			}
+			if s := d.String(); s != tt.str {
+				t.Errorf("String() = %q, want %q", s, tt.str)
+			}
+			if s := d.Kanji(); s != tt.kanji {
+				t.Errorf("Kanji() = %q, want %q", s, tt.kanji)
+			}
+		})
+	}
+}
+
+func TestJapanese_eraNames(t *testing.T) {
+	want := Gregorian.ToJD(1945, 8, 15)
+	for _, era := range []string{"Shōwa", "showa", "SHOWA", "昭和", "S", "s"} {
+		if got, err := Japanese.ToJD(era, 20, 8, 15); err != nil || got != want {
+			t.Errorf("Japanese.ToJD(%q, 20, 8, 15) = %v, %v, want %v", era, got, err, want)
+		}
+	}
+}
+
+func TestJapanese_errors(t *testing.T) {
+	tests := []struct {
+		era              string
+		year, month, day int
+		err              error
+	}{
+		{"Edo", 1, 1, 1, julian.ErrSyntax},
+		{"Heisei", 31, 5, 1, julian.ErrRange},
+		{"Reiwa", 1, 4, 30, julian.ErrRange},
+		{"Meiji", 1, 1, 1, julian.ErrRange},
+		{"Reiwa", 0, 5, 1, julian.ErrRange},
+		{"Reiwa", 6, 2, 30, julian.ErrRange},
+		{"Reiwa", 6, 13, 1, julian.ErrRange},
+	}
+	for _, tt := range tests {
+		if _, err := Japanese.ToJD(tt.era, tt.year, tt.month, tt.day); !errors.Is(err, tt.err) {
+			t.Errorf("Japanese.ToJD(%q, %v, %v, %v) error = %v, want %v", tt.era, tt.year, tt.month, tt.day, err, tt.err)
+		}
+	}
+	if _, err := Japanese.FromJD(Gregorian.ToJD(1868, 10, 22)); !errors.Is(err, julian.ErrRange) {
+		t.Errorf("Japanese.FromJD before Meiji error = %v, want %v", err, julian.ErrRange)
+	}
+}
+
+func TestJapanese_newEra(t *testing.T) {
+	c := JapaneseCalendar{append(JapaneseEras[:len(JapaneseEras):len(JapaneseEras)], JapaneseEra{"Test", "試験", "X", Gregorian.ToJD(2100, 1, 1)})}
+	d, err := c.FromJD(Gregorian.ToJD(2100, 6, 1))
+	if err != nil || d.String() != "Test 1-06-01" {
+		t.Errorf("FromJD = %v, %v, want Test 1-06-01", d, err)
+	}
+	if _, err := c.ToJD("Reiwa", 82, 1, 1); !errors.Is(err, julian.ErrRange) {
+		t.Errorf("ToJD(Reiwa 82-01-01) error = %v, want %v", err, julian.ErrRange)
+	}
+	if d, _ := Japanese.FromJD(Gregorian.ToJD(2100, 6, 1)); d.String() != "Reiwa 82-06-01" {
+		t.Errorf("Japanese.FromJD = %v, want Reiwa 82-06-01", d)
+	}
+}
+
+func TestJapanese_roundTrip(t *testing.T) {
+	for jd := JapaneseEras[0].Start; jd < 2_500_000; jd += 37 {
+		d, err := Japanese.FromJD(jd)
+		if err != nil {
+			t.Fatalf("Japanese.FromJD(%v) error = %v", jd, err)
+		}
+		if got, err := Japanese.ToJD(d.Era.Name, d.Year, d.Month, d.Day); err != nil || got != jd {
+			t.Fatalf("Japanese.ToJD(%v) = %v, %v, want %v", d, got, err, jd)
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package calendar
+
+import (
+	"time"
+
+	"github.com/pachecot/julian"
+)
+
+// JulianCalendar is the proleptic Julian calendar, with a leap year every
+// fourth year. Years are numbered astronomically, so the year 1 BC is 0.
+//
+// It gives the same dates as julian.Date.DateIn with julian.ProlepticJulian,
+// which is the simpler choice for a single conversion; JulianCalendar is for
+// use with the other calendars of this package and the Calendar interface.
+type JulianCalendar struct{}
+
+// Julian is the proleptic Julian calendar.
+var Julian JulianCalendar
+
+// julian_epoch is the fixed day number of January 1, 1 in the Julian
+// calendar, December 30, 0 in the Gregorian.
+const julian_epoch = -1
+
+// IsLeapYear reports whether year is a leap year in the Julian calendar.
+func (JulianCalendar) IsLeapYear(year int) bool {
+	return floorMod(int64(year), 4) == 0
+}
+
+// ToJD returns the julian date of the midnight UTC that starts the given
+// Julian calendar date. Months and days outside their usual ranges are
+// counted on from the start of the year and month, so October 32 is
+// November 1.
+func (c JulianCalendar) ToJD(year, month, day int) julian.Date {
+	return jdFromFixed(c.fixed(year, month, day))
+}
+
+// FromJD returns the Julian calendar date of the UTC day containing jd.
+func (c JulianCalendar) FromJD(jd julian.Date) (year, month, day int) {
+	return c.fromFixed(fixedFromJD(jd))
+}
+
+func (c JulianCalendar) fixed(year, month, day int) int64 {
+	y := int64(year) + floorDiv(int64(month-1), 12)
+	m := floorMod(int64(month-1), 12) + 1
+	f := julian_epoch - 1 + 365*(y-1) + floorDiv(y-1, 4) + (367*m-362)/12 + int64(day)
+	switch {
+	case m <= 2:
+	case c.IsLeapYear(int(y)):
+		f--
+	default:
+		f -= 2
+	}
+	return f
+}
+
+func (c JulianCalendar) fromFixed(f int64) (year, month, day int) {
+	year = int(floorDiv(4*(f-julian_epoch)+1464, 1461))
+	prior := f - c.fixed(year, 1, 1)
+	var correction int64
+	if f >= c.fixed(year, 3, 1) {
+		correction = 2
+		if c.IsLeapYear(year) {
+			correction = 1
+		}
+	}
+	month = int(floorDiv(12*(prior+correction)+373, 367))
+	day = int(f-c.fixed(year, month, 1)) + 1
+	return year, month, day
+}
+
+// Name returns the name of the calendar, "Julian".
+func (JulianCalendar) Name() string { return "Julian" }
+
+// ToJDN returns the julian day number of the date.
+func (c JulianCalendar) ToJDN(f Fields) int64 { return toJDN(c.fixed(f.Year, f.Month, f.Day)) }
+
+// FromJDN returns the date of the day with the julian day number jdn.
+func (c JulianCalendar) FromJDN(jdn int64) Fields {
+	y, m, d := c.fromFixed(fromJDN(jdn))
+	return Fields{y, m, d}
+}
+
+// MonthsInYear returns 12.
+func (JulianCalendar) MonthsInYear(year int) int { return 12 }
+
+// MonthName returns the English name of the month, e.g. "March".
+func (JulianCalendar) MonthName(year, month int) string { return time.Month(month).String() }
+
+// Era returns "AD". Years before AD 1 are numbered 0, -1, and so on.
+func (JulianCalendar) Era() string { return "AD" }
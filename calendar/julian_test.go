@@ -0,0 +1,62 @@
+package calendar
+
+import (
+	"testing"
+
+	"github.com/pachecot/julian"
+)
+
+func TestJulian(t *testing.T) {
+	tests := []struct {
+		name             string
+		jd               julian.Date
+		year, month, day int
+	}{
+		{"epoch", -0.5, -4712, 1, 1},
+		{"1 BC", 1_721_057.5, 0, 1, 1},
+		{"AD 1", 1_721_423.5, 1, 1, 1},
+		{"last Julian day", 2_299_159.5, 1582, 10, 4},
+		{"first Gregorian day", 2_299_160.5, 1582, 10, 5},
+		{"British switch", 2_361_220.5, 1752, 9, 2},
+		{"J2000", 2_451_544.5, 1999, 12, 19},
+		{"leap day", 2_451_616.5, 2000, 2, 29},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Julian.ToJD(tt.year, tt.month, tt.day); got != tt.jd {
+				t.Errorf("Julian.ToJD(%v, %v, %v) = %v, want %v", tt.year, tt.month, tt.day, got, tt.jd)
+			}
+			y, m, d := Julian.FromJD(tt.jd + 0.75)
+			if y != tt.year || m != tt.month || d != tt.day {
+				t.Errorf("Julian.FromJD(%v) = %v, %v, %v, want %v, %v, %v", tt.jd+0.75, y, m, d, tt.year, tt.month, tt.day)
+			}
+		})
+	}
+}
+
+func TestJulian_package(t *testing.T) {
+	for jd := julian.Date(-800_000.5); jd < 4_000_000; jd += 997 {
+		y, m, d := Julian.FromJD(jd)
+		wy, wm, wd := jd.DateIn(julian.ProlepticJulian)
+		if y != wy || m != int(wm) || d != wd {
+			t.Fatalf("Julian.FromJD(%v) = %v, %v, %v, want %v, %v, %v", jd, y, m, d, wy, int(wm), wd)
+		}
+		if got := Julian.ToJD(y, m, d); got != jd {
+			t.Fatalf("Julian.ToJD(%v, %v, %v) = %v, want %v", y, m, d, got, jd)
+		}
+	}
+	// the two implementations agree at any time of day over a wide range
+	for jd := julian.Date(-10_000_000.3); jd < 10_000_000; jd += 9_973.37 {
+		y, m, d := Julian.FromJD(jd)
+		wy, wm, wd := jd.DateIn(julian.ProlepticJulian)
+		if y != wy || m != int(wm) || d != wd {
+			t.Fatalf("Julian.FromJD(%v) = %v, %v, %v, want %v, %v, %v", jd, y, m, d, wy, int(wm), wd)
+		}
+	}
+	if got := Julian.ToJD(1582, 10, 32); got != Julian.ToJD(1582, 11, 1) {
+		t.Errorf("Julian.ToJD(1582, 10, 32) = %v, want November 1", got)
+	}
+	if got := Julian.ToJD(1582, 13, 1); got != Julian.ToJD(1583, 1, 1) {
+		t.Errorf("Julian.ToJD(1582, 13, 1) = %v, want January 1, 1583", got)
+	}
+}
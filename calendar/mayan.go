@@ -0,0 +1,146 @@
+package calendar
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pachecot/julian"
+)
+
+// GMTCorrelation is the Goodman-Martinez-Thompson correlation, the julian
+// day number of the Long Count epoch, 4 Ajaw 8 Kumk'u, August 11, 3114 BC
+// (Gregorian). Lounsbury's alternative is GMTCorrelation+2.
+const GMTCorrelation = 584_283
+
+// A MayanCalendar converts julian dates to the Long Count and the Calendar
+// Round of the Maya, given the correlation between the two.
+type MayanCalendar struct {
+	// Correlation is the julian day number of the Long Count epoch.
+	Correlation int64
+}
+
+// Mayan is the Maya calendar with the GMT correlation.
+var Mayan = MayanCalendar{GMTCorrelation}
+
+// A LongCount is a count of days from the Long Count epoch in the mixed
+// radix of the Maya: a baktun is 20 katun, a katun 20 tun, a tun 18 uinal
+// and a uinal 20 kin, or days. The epoch, traditionally written 13.0.0.0.0,
+// is 0.0.0.0.0 here, so that the counts of later dates increase from it;
+// the baktun is negative before it.
+type LongCount struct {
+	Baktun, Katun, Tun, Uinal, Kin int
+}
+
+// Days returns the number of days from the epoch to lc.
+func (lc LongCount) Days() int64 {
+	return int64(lc.Baktun)*144000 + int64(lc.Katun)*7200 + int64(lc.Tun)*360 + int64(lc.Uinal)*20 + int64(lc.Kin)
+}
+
+// longCountOf returns the Long Count of the given number of days from the
+// epoch.
+func longCountOf(days int64) LongCount {
+	baktun := floorDiv(days, 144000)
+	d := days - baktun*144000
+	return LongCount{int(baktun), int(d / 7200), int(d % 7200 / 360), int(d % 360 / 20), int(d % 20)}
+}
+
+// String returns the Long Count in dotted notation, e.g. "13.0.0.0.0".
+func (lc LongCount) String() string {
+	return fmt.Sprintf("%d.%d.%d.%d.%d", lc.Baktun, lc.Katun, lc.Tun, lc.Uinal, lc.Kin)
+}
+
+// ParseLongCount parses a Long Count in the dotted notation returned by
+// LongCount.String. The places after the baktun must be in range.
+func ParseLongCount(s string) (LongCount, error) {
+	parts := strings.Split(strings.TrimSpace(s), ".")
+	if len(parts) != 5 {
+		return LongCount{}, fmt.Errorf("%w: %q", julian.ErrSyntax, s)
+	}
+	var v [5]int
+	for i, p := range parts {
+		n, err := strconv.Atoi(p)
+		if err != nil || i > 0 && (n < 0 || n >= longCountRadix[i]) {
+			return LongCount{}, fmt.Errorf("%w: %q", julian.ErrSyntax, s)
+		}
+		v[i] = n
+	}
+	return LongCount{v[0], v[1], v[2], v[3], v[4]}, nil
+}
+
+// longCountRadix is the number of units in each place of a Long Count.
+var longCountRadix = [5]int{0, 20, 20, 18, 20}
+
+// A Tzolkin is a day of the 260-day ritual count, a Number from 1 to 13 and
+// a Name from 1 (Imix) to 20 (Ajaw) advancing together.
+type Tzolkin struct {
+	Number, Name int
+}
+
+// String returns the day, e.g. "4 Ajaw".
+func (t Tzolkin) String() string {
+	if t.Name < 1 || t.Name > len(tzolkinNames) {
+		return strconv.Itoa(t.Number) + " %!Name(" + strconv.Itoa(t.Name) + ")"
+	}
+	return strconv.Itoa(t.Number) + " " + tzolkinNames[t.Name-1]
+}
+
+var tzolkinNames = [...]string{
+	"Imix", "Ik'", "Ak'b'al", "K'an", "Chikchan", "Kimi", "Manik'", "Lamat", "Muluk", "Ok",
+	"Chuwen", "Eb'", "B'en", "Ix", "Men", "K'ib'", "Kab'an", "Etz'nab'", "Kawak", "Ajaw",
+}
+
+// A Haab is a day of the 365-day civil year, eighteen months of 20 days and
+// the 5 days of Wayeb', with the Day from 0 to 19 and the Month from 1 (Pop)
+// to 19 (Wayeb').
+type Haab struct {
+	Day, Month int
+}
+
+// String returns the day, e.g. "8 Kumk'u".
+func (h Haab) String() string {
+	if h.Month < 1 || h.Month > len(haabMonths) {
+		return strconv.Itoa(h.Day) + " %!Month(" + strconv.Itoa(h.Month) + ")"
+	}
+	return strconv.Itoa(h.Day) + " " + haabMonths[h.Month-1]
+}
+
+var haabMonths = [...]string{
+	"Pop", "Wo'", "Sip", "Sotz'", "Sek", "Xul", "Yaxk'in", "Mol", "Ch'en", "Yax",
+	"Sak'", "Keh", "Mak", "K'ank'in", "Muwan", "Pax", "K'ayab", "Kumk'u", "Wayeb'",
+}
+
+// days returns the number of days from the Long Count epoch to the UTC day
+// containing jd.
+func (c MayanCalendar) days(jd julian.Date) int64 {
+	return fixedFromJD(jd) + jdn_rd - c.Correlation
+}
+
+// LongCount returns the Long Count of the UTC day containing jd.
+func (c MayanCalendar) LongCount(jd julian.Date) LongCount {
+	return longCountOf(c.days(jd))
+}
+
+// FromLongCount returns the julian date of the midnight UTC that starts the
+// day lc.
+func (c MayanCalendar) FromLongCount(lc LongCount) julian.Date {
+	return jdFromFixed(lc.Days() + c.Correlation - jdn_rd)
+}
+
+// Tzolkin returns the Tzolk'in day of the UTC day containing jd.
+func (c MayanCalendar) Tzolkin(jd julian.Date) Tzolkin {
+	d := c.days(jd)
+	return Tzolkin{int(floorMod(d+3, 13)) + 1, int(floorMod(d+19, 20)) + 1}
+}
+
+// Haab returns the Haab' day of the UTC day containing jd.
+func (c MayanCalendar) Haab(jd julian.Date) Haab {
+	d := floorMod(c.days(jd)+348, 365)
+	return Haab{int(d % 20), int(d/20) + 1}
+}
+
+// CalendarRound returns the Tzolk'in and Haab' days of the UTC day
+// containing jd, which together repeat every 52 Haab' years.
+func (c MayanCalendar) CalendarRound(jd julian.Date) (Tzolkin, Haab) {
+	return c.Tzolkin(jd), c.Haab(jd)
+}
@@ -0,0 +1,77 @@
+package calendar
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/pachecot/julian"
+)
+
+func TestMayan(t *testing.T) {
+	tests := []struct {
+		name    string
+		jd      julian.Date
+		lc      string
+		tzolkin string
+		haab    string
+	}{
+		{"epoch", Gregorian.ToJD(-3113, 8, 11), "0.0.0.0.0", "4 Ajaw", "8 Kumk'u"},
+		{"before epoch", Gregorian.ToJD(-3113, 8, 10), "-1.19.19.17.19", "3 Kawak", "7 Kumk'u"},
+		{"2012", Gregorian.ToJD(2012, 12, 21), "13.0.0.0.0", "4 Ajaw", "3 K'ank'in"},
+		{"Wayeb'", Gregorian.ToJD(2013, 3, 31), "13.0.0.5.0", "13 Ajaw", "3 Wayeb'"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lc := Mayan.LongCount(tt.jd + 0.5)
+			if lc.String() != tt.lc {
+				t.Errorf("Mayan.LongCount(%v) = %v, want %v", tt.jd, lc, tt.lc)
+			}
+			if got := Mayan.FromLongCount(lc); got != tt.jd {
+				t.Errorf("Mayan.FromLongCount(%v) = %v, want %v", lc, got, tt.jd)
+			}
+			tz, h := Mayan.CalendarRound(tt.jd)
+			if tz.String() != tt.tzolkin || h.String() != tt.haab {
+				t.Errorf("Mayan.CalendarRound(%v) = %v %v, want %v %v", tt.jd, tz, h, tt.tzolkin, tt.haab)
+			}
+		})
+	}
+}
+
+func TestMayan_correlation(t *testing.T) {
+	lounsbury := MayanCalendar{GMTCorrelation + 2}
+	jd := Gregorian.ToJD(2012, 12, 23)
+	if got := lounsbury.LongCount(jd); got != (LongCount{13, 0, 0, 0, 0}) {
+		t.Errorf("LongCount(%v) = %v, want 13.0.0.0.0", jd, got)
+	}
+	if got := lounsbury.Tzolkin(jd); got != (Tzolkin{4, 20}) {
+		t.Errorf("Tzolkin(%v) = %v, want 4 Ajaw", jd, got)
+	}
+}
+
+func TestParseLongCount(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    LongCount
+		wantErr bool
+	}{
+		{"13.0.0.0.0", LongCount{13, 0, 0, 0, 0}, false},
+		{" 9.12.11.5.18 ", LongCount{9, 12, 11, 5, 18}, false},
+		{"-1.19.19.17.19", LongCount{-1, 19, 19, 17, 19}, false},
+		{"9.12.11.18.0", LongCount{}, true},
+		{"9.12.11.5", LongCount{}, true},
+		{"9.12.x.5.18", LongCount{}, true},
+	}
+	for _, tt := range tests {
+		got, err := ParseLongCount(tt.in)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("ParseLongCount(%q) = %v, %v, want %v", tt.in, got, err, tt.want)
+		}
+		if err != nil && !errors.Is(err, julian.ErrSyntax) {
+			t.Errorf("ParseLongCount(%q) error = %v, want ErrSyntax", tt.in, err)
+		}
+		if err == nil && got.String() != strings.TrimSpace(tt.in) {
+			t.Errorf("LongCount.String() = %q, want %q", got, tt.in)
+		}
+	}
+}
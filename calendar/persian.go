@@ -0,0 +1,139 @@
+package calendar
+
+import "github.com/pachecot/julian"
+
+// A PersianCalendar is the Solar Hijri calendar of Iran and Afghanistan.
+// The first six months have 31 days, the next five 30, and Esfand (12) has
+// 29 days, or 30 in a leap year. Years are counted from the Hijra, AP 1
+// starting on March 19, 622 (Julian) by the astronomical rule; the year
+// before AP 1 is 0.
+type PersianCalendar struct {
+	// Astronomical selects the official rule, by which the year starts on
+	// the day of the March equinox if it falls before noon Iran Standard
+	// Time and on the next day otherwise, in place of the 33-year cycle.
+	Astronomical bool
+}
+
+var (
+	// Persian is the Persian calendar with the arithmetic 33-year cycle,
+	// which has a leap year in the years 1, 5, 9, 13, 17, 22, 26 and 30 of
+	// each cycle and agrees with the astronomical rule from AP 1178 to 1633
+	// (1799 to 2255). Its epoch is set a day early to align the cycle with
+	// the modern calendar, so it puts AP 1 on March 18, 622 (Julian) and
+	// departs from the astronomical rule on 81 new year's days before
+	// AP 1178; use it only from then on.
+	Persian = PersianCalendar{}
+
+	// PersianAstronomical is the Persian calendar with the equinox rule.
+	PersianAstronomical = PersianCalendar{Astronomical: true}
+)
+
+const (
+	persian_epoch = 226_896 // fixed day of 1 Farvardin AP 1
+	iran_offset   = 3.5     // Iran Standard Time, UTC+3:30, in hours
+
+	// persian_cycle_epoch is the epoch that aligns the 33-year cycle with
+	// the modern calendar. Run back to AP 1 the cycle gains a day on the
+	// equinox, so it starts a day before the historical epoch.
+	persian_cycle_epoch = persian_epoch - 1
+)
+
+// IsLeapYear reports whether year has 366 days.
+func (c PersianCalendar) IsLeapYear(year int) bool {
+	if c.Astronomical {
+		return c.newYear(year+1)-c.newYear(year) == 366
+	}
+	return floorMod(25*int64(year)+11, 33) < 8
+}
+
+// DaysInMonth returns the number of days in the given month of year.
+func (c PersianCalendar) DaysInMonth(year, month int) int {
+	switch {
+	case month <= 6:
+		return 31
+	case month <= 11 || c.IsLeapYear(year):
+		return 30
+	}
+	return 29
+}
+
+// ToJD returns the julian date of the midnight UTC that starts the given
+// Persian date. The month must be in the range [1, 12]; days outside the
+// month are counted on from its first day.
+func (c PersianCalendar) ToJD(year, month, day int) julian.Date {
+	return jdFromFixed(c.fixed(year, month, day))
+}
+
+// FromJD returns the Persian date of the UTC day containing jd.
+func (c PersianCalendar) FromJD(jd julian.Date) (year, month, day int) {
+	return c.fromFixed(fixedFromJD(jd))
+}
+
+func (c PersianCalendar) fixed(year, month, day int) int64 {
+	m := int64(month)
+	f := c.newYear(year) + int64(day) - 1
+	if m <= 7 {
+		return f + 31*(m-1)
+	}
+	return f + 30*(m-1) + 6
+}
+
+// newYear returns the fixed day of 1 Farvardin of year.
+func (c PersianCalendar) newYear(year int) int64 {
+	if c.Astronomical {
+		return noonDay(equinox(year+621, false), iran_offset)
+	}
+	y := int64(year) - 1
+	return persian_cycle_epoch + 365*y + floorDiv(8*y+29, 33)
+}
+
+func (c PersianCalendar) fromFixed(f int64) (year, month, day int) {
+	// a 33-year cycle has 12053 days
+	year = int(floorDiv(33*(f-persian_cycle_epoch), 12053)) + 1
+	for c.newYear(year) > f {
+		year--
+	}
+	for c.newYear(year+1) <= f {
+		year++
+	}
+	doy := f - c.newYear(year)
+	if doy < 186 {
+		return year, int(doy/31) + 1, int(doy%31) + 1
+	}
+	doy -= 186
+	return year, int(doy/30) + 7, int(doy%30) + 1
+}
+
+// Name returns the name of the calendar, "Persian" or "Persian
+// (astronomical)".
+func (c PersianCalendar) Name() string {
+	if c.Astronomical {
+		return "Persian (astronomical)"
+	}
+	return "Persian"
+}
+
+// ToJDN returns the julian day number of the date.
+func (c PersianCalendar) ToJDN(f Fields) int64 { return toJDN(c.fixed(f.Year, f.Month, f.Day)) }
+
+// FromJDN returns the date of the day with the julian day number jdn.
+func (c PersianCalendar) FromJDN(jdn int64) Fields {
+	y, m, d := c.fromFixed(fromJDN(jdn))
+	return Fields{y, m, d}
+}
+
+// MonthsInYear returns 12.
+func (PersianCalendar) MonthsInYear(year int) int { return 12 }
+
+// MonthName returns the name of the month, e.g. "Farvardin".
+func (PersianCalendar) MonthName(year, month int) string {
+	return monthName(persianMonths[:], month)
+}
+
+var persianMonths = [...]string{
+	"Farvardin", "Ordibehesht", "Khordad", "Tir", "Mordad", "Shahrivar",
+	"Mehr", "Aban", "Azar", "Dey", "Bahman", "Esfand",
+}
+
+// Era returns "AP", Anno Persico.
+func (PersianCalendar) Era() string { return "AP" }
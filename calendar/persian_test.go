@@ -0,0 +1,78 @@
+package calendar
+
+import (
+	"testing"
+
+	"github.com/pachecot/julian"
+)
+
+func TestPersian(t *testing.T) {
+	tests := []struct {
+		name             string
+		jd               julian.Date
+		year, month, day int
+	}{
+		{"Nowruz 1354", Gregorian.ToJD(1975, 3, 21), 1354, 1, 1},
+		{"Nowruz 1403", Gregorian.ToJD(2024, 3, 20), 1403, 1, 1},
+		{"Esfand 30, 1403", Gregorian.ToJD(2025, 3, 20), 1403, 12, 30},
+		{"Nowruz 1404", Gregorian.ToJD(2025, 3, 21), 1404, 1, 1},
+		{"Mehr 1403", Gregorian.ToJD(2024, 9, 22), 1403, 7, 1},
+		{"Shahrivar 31, 1403", Gregorian.ToJD(2024, 9, 21), 1403, 6, 31},
+	}
+	for _, cal := range []PersianCalendar{Persian, PersianAstronomical} {
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				if got := cal.ToJD(tt.year, tt.month, tt.day); got != tt.jd {
+					t.Errorf("%+v.ToJD(%v, %v, %v) = %v, want %v", cal, tt.year, tt.month, tt.day, got, tt.jd)
+				}
+				y, m, d := cal.FromJD(tt.jd + 0.5)
+				if y != tt.year || m != tt.month || d != tt.day {
+					t.Errorf("%+v.FromJD(%v) = %v, %v, %v, want %v, %v, %v", cal, tt.jd+0.5, y, m, d, tt.year, tt.month, tt.day)
+				}
+			})
+		}
+	}
+	if got := PersianAstronomical.ToJD(1, 1, 1); got != Julian.ToJD(622, 3, 19) {
+		t.Errorf("PersianAstronomical.ToJD(1, 1, 1) = %v, want March 19, 622 (Julian)", got)
+	}
+	if got := Persian.ToJD(1, 1, 1); got != Julian.ToJD(622, 3, 18) {
+		t.Errorf("Persian.ToJD(1, 1, 1) = %v, want March 18, 622 (Julian)", got)
+	}
+}
+
+func TestPersian_agree(t *testing.T) {
+	for y := 1178; y < 1634; y++ {
+		if Persian.IsLeapYear(y) != PersianAstronomical.IsLeapYear(y) {
+			t.Errorf("Persian.IsLeapYear(%v) = %v, disagrees with the equinox rule", y, Persian.IsLeapYear(y))
+		}
+	}
+}
+
+func TestPersian_newYearDrift(t *testing.T) {
+	n := 0
+	for y := 1; y < 1634; y++ {
+		if Persian.ToJD(y, 1, 1) != PersianAstronomical.ToJD(y, 1, 1) {
+			if y >= 1178 {
+				t.Errorf("Persian.ToJD(%v, 1, 1) disagrees with the equinox rule", y)
+			}
+			n++
+		}
+	}
+	if n != 81 {
+		t.Errorf("Persian and PersianAstronomical disagree on %v new year's days, want 81", n)
+	}
+}
+
+func TestPersian_roundTrip(t *testing.T) {
+	for _, cal := range []PersianCalendar{Persian, PersianAstronomical} {
+		for jd := julian.Date(2_000_000.5); jd < 2_800_000; jd += 97 {
+			y, m, d := cal.FromJD(jd)
+			if got := cal.ToJD(y, m, d); got != jd {
+				t.Fatalf("%+v.ToJD(FromJD(%v)) = %v (%v, %v, %v)", cal, jd, got, y, m, d)
+			}
+			if m < 1 || m > 12 || d < 1 || d > cal.DaysInMonth(y, m) {
+				t.Fatalf("%+v.FromJD(%v) = %v, %v, %v, out of range", cal, jd, y, m, d)
+			}
+		}
+	}
+}
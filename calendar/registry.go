@@ -0,0 +1,116 @@
+package calendar
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Fields are the year, month and day of a date in some calendar.
+type Fields struct {
+	Year, Month, Day int
+}
+
+// A Calendar converts between julian day numbers and the dates of a
+// calendar. The calendars of this package implement it, except Japanese and
+// Mayan as the package documentation explains, and other packages may add
+// their own with Register.
+//
+// The months of a year are numbered from 1 to MonthsInYear, though not
+// necessarily in the order in which they fall in the year.
+type Calendar interface {
+	// Name returns the name under which the calendar is registered.
+	Name() string
+
+	// ToJDN returns the julian day number of the date, the julian date of
+	// its noon UTC.
+	ToJDN(f Fields) int64
+
+	// FromJDN returns the date of the day with the julian day number jdn.
+	FromJDN(jdn int64) Fields
+
+	// MonthsInYear returns the number of months in year, counting
+	// intercalary days outside any month as a month of their own.
+	MonthsInYear(year int) int
+
+	// MonthName returns the name of the month of year.
+	MonthName(year, month int) string
+
+	// Era returns the abbreviation of the era in which years are counted,
+	// e.g. "AH".
+	Era() string
+}
+
+// jdn_rd is the julian day number of fixed day 0.
+const jdn_rd = 1_721_425
+
+// toJDN and fromJDN adapt the fixed day numbers of this package to julian
+// day numbers.
+func toJDN(f int64) int64     { return f + jdn_rd }
+func fromJDN(jdn int64) int64 { return jdn - jdn_rd }
+
+var registry struct {
+	sync.RWMutex
+	m map[string]Calendar
+}
+
+// Register makes a calendar available by its name in Lookup. It panics if
+// the name is empty or a calendar of the same name is already registered.
+// Names are compared without regard to case.
+func Register(c Calendar) {
+	name := strings.ToLower(c.Name())
+	registry.Lock()
+	defer registry.Unlock()
+	if name == "" {
+		panic("calendar: Register of calendar with empty name")
+	}
+	if _, dup := registry.m[name]; dup {
+		panic("calendar: Register called twice for calendar " + c.Name())
+	}
+	if registry.m == nil {
+		registry.m = make(map[string]Calendar)
+	}
+	registry.m[name] = c
+}
+
+// Lookup returns the registered calendar with the given name, compared
+// without regard to case.
+func Lookup(name string) (Calendar, bool) {
+	registry.RLock()
+	defer registry.RUnlock()
+	c, ok := registry.m[strings.ToLower(name)]
+	return c, ok
+}
+
+// Calendars returns the sorted names of the registered calendars.
+func Calendars() []string {
+	registry.RLock()
+	defer registry.RUnlock()
+	names := make([]string, 0, len(registry.m))
+	for _, c := range registry.m {
+		names = append(names, c.Name())
+	}
+	slices.Sort(names)
+	return names
+}
+
+func init() {
+	for _, c := range []Calendar{
+		Gregorian, Julian, Papal, British, Russian,
+		Hebrew, IslamicCivil, IslamicAstronomical,
+		Persian, PersianAstronomical, Coptic, Ethiopian, EthiopianAmeteAlem,
+		FrenchRepublican, FrenchRepublicanRomme, Saka, Bahai,
+	} {
+		Register(c)
+	}
+}
+
+// monthName returns names[month-1], or a placeholder in the style of
+// time.Month for a month out of range.
+func monthName(names []string, month int) string {
+	if month < 1 || month > len(names) {
+		return "%!Month(" + strconv.Itoa(month) + ")"
+	}
+	return names[month-1]
+}
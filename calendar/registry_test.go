@@ -0,0 +1,108 @@
+package calendar
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestCalendars(t *testing.T) {
+	names := Calendars()
+	if len(names) < 17 || !slices.IsSorted(names) || !slices.Contains(names, "Baháʼí") {
+		t.Errorf("Calendars() = %q", names)
+	}
+	for _, name := range names {
+		c, ok := Lookup(name)
+		if !ok || c.Name() != name {
+			t.Fatalf("Lookup(%q) = %v, %v", name, c, ok)
+		}
+		for jdn := int64(2_300_000); jdn < 2_600_000; jdn += 997 {
+			f := c.FromJDN(jdn)
+			if got := c.ToJDN(f); got != jdn {
+				t.Fatalf("%s: ToJDN(FromJDN(%v)) = %v (%v)", name, jdn, got, f)
+			}
+			if f.Month < 1 || f.Month > c.MonthsInYear(f.Year) {
+				t.Fatalf("%s: FromJDN(%v) = %v, month out of range", name, jdn, f)
+			}
+		}
+	}
+	for _, name := range names {
+		c, _ := Lookup(name)
+		for _, year := range []int{2, 1403, 1945, 5784, 7517} {
+			for m := 1; m <= c.MonthsInYear(year); m++ {
+				if got := c.MonthName(year, m); strings.HasPrefix(got, "%!") {
+					t.Errorf("%s: MonthName(%v, %v) = %q", name, year, m, got)
+				}
+			}
+		}
+	}
+	if c, ok := Lookup("islamic"); !ok || c != IslamicCivil {
+		t.Errorf(`Lookup("islamic") = %v, %v, want IslamicCivil`, c, ok)
+	}
+	if _, ok := Lookup("Klingon"); ok {
+		t.Error(`Lookup("Klingon") = true`)
+	}
+}
+
+func TestCalendar_metadata(t *testing.T) {
+	tests := []struct {
+		cal         Calendar
+		year, month int
+		name, era   string
+	}{
+		{Gregorian, 2024, 3, "March", "AD"},
+		{Papal, 1582, 10, "October", "AD"},
+		{Hebrew, 5784, 12, "Adar I", "AM"},
+		{Hebrew, 5783, 12, "Adar", "AM"},
+		{Hebrew, 5784, 13, "Adar II", "AM"},
+		{IslamicCivil, 1445, 9, "Ramadan", "AH"},
+		{Persian, 1403, 1, "Farvardin", "AP"},
+		{Coptic, 1741, 13, "Pi Kogi Enavot", "AM"},
+		{EthiopianAmeteAlem, 7517, 1, "Meskerem", "AA"},
+		{FrenchRepublican, 2, 11, "Thermidor", "ER"},
+		{Saka, 1946, 1, "Chaitra", "SE"},
+		{Bahai, 181, 0, "Ayyám-i-Há", "BE"},
+		{Bahai, 181, 20, "Ayyám-i-Há", "BE"},
+		{Saka, 1946, 13, "%!Month(13)", "SE"},
+	}
+	for _, tt := range tests {
+		if got := tt.cal.MonthName(tt.year, tt.month); got != tt.name {
+			t.Errorf("%s.MonthName(%v, %v) = %q, want %q", tt.cal.Name(), tt.year, tt.month, got, tt.name)
+		}
+		if got := tt.cal.Era(); got != tt.era {
+			t.Errorf("%s.Era() = %q, want %q", tt.cal.Name(), got, tt.era)
+		}
+	}
+	if got := British.Name(); got != "Julian/Gregorian 1752-09-14" {
+		t.Errorf("British.Name() = %q", got)
+	}
+}
+
+// dayCount is a calendar of one month of a million days, to test Register.
+type dayCount struct{}
+
+func (dayCount) Name() string         { return "Day count" }
+func (dayCount) ToJDN(f Fields) int64 { return int64(f.Year)*1_000_000 + int64(f.Day) - 1 }
+func (dayCount) FromJDN(jdn int64) Fields {
+	return Fields{int(jdn / 1_000_000), 1, int(jdn%1_000_000) + 1}
+}
+func (dayCount) MonthsInYear(year int) int        { return 1 }
+func (dayCount) MonthName(year, month int) string { return "Days" }
+func (dayCount) Era() string                      { return "JD" }
+
+func TestRegister(t *testing.T) {
+	Register(dayCount{})
+	c, ok := Lookup("DAY COUNT")
+	if !ok {
+		t.Fatal(`Lookup("DAY COUNT") = false after Register`)
+	}
+	if got := c.FromJDN(2_451_545); got != (Fields{2, 1, 451_546}) {
+		t.Errorf("FromJDN(2451545) = %v", got)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Error("Register() of a duplicate name did not panic")
+		}
+	}()
+	Register(Gregorian)
+}
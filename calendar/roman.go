@@ -0,0 +1,281 @@
+package calendar
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pachecot/julian"
+)
+
+// A RomanEvent is one of the three fixed days of a Roman month from which
+// the other days are counted back.
+type RomanEvent int
+
+const (
+	Kalends RomanEvent = 1 + iota // the first day of the month
+	Nones                         // the 5th, or the 7th in March, May, July and October
+	Ides                          // the 13th, or the 15th in March, May, July and October
+)
+
+// String returns the abbreviated Latin name of the event, e.g. "Kal.".
+func (e RomanEvent) String() string {
+	switch e {
+	case Kalends:
+		return "Kal."
+	case Nones:
+		return "Non."
+	case Ides:
+		return "Id."
+	}
+	return "RomanEvent(" + strconv.Itoa(int(e)) + ")"
+}
+
+// A RomanDate is a date of the Julian calendar written in the Roman style,
+// as the number of days to the next Kalends, Nones or Ides, counted
+// inclusively, so that the day before an event is its second day.
+type RomanDate struct {
+	// Year is the year ab urbe condita (AUC) of the day itself, even when
+	// the day is counted to the Kalends of January of the next year.
+	Year  int
+	Month int // the month of the event, 1 to 12
+	Event RomanEvent
+	Count int // days to the event, 1 on the event itself
+
+	// Leap marks the day added in a leap year, the second sixth day before
+	// the Kalends of March, a.d. bis VI Kal. Mart.
+	Leap bool
+}
+
+// auc_offset is the year AUC of 1 BC, from Varro's foundation of Rome in
+// 753 BC.
+const auc_offset = 753
+
+// nonesOf returns the day of the Nones of month; the Ides are 8 days later.
+func nonesOf(month int) int {
+	switch month {
+	case 3, 5, 7, 10:
+		return 7
+	}
+	return 5
+}
+
+// Roman returns the Roman date of the UTC day containing jd, read in the
+// Julian calendar.
+func Roman(jd julian.Date) RomanDate {
+	f := fixedFromJD(jd)
+	y, m, d := Julian.fromFixed(f)
+	r := RomanDate{Year: y + auc_offset, Month: m}
+	nones := nonesOf(m)
+	switch {
+	case d == 1:
+		r.Event, r.Count = Kalends, 1
+	case d <= nones:
+		r.Event, r.Count = Nones, nones-d+1
+	case d <= nones+8:
+		r.Event, r.Count = Ides, nones+8-d+1
+	case m != 2 || !Julian.IsLeapYear(y):
+		r.Month = m%12 + 1
+		r.Event = Kalends
+		r.Count = int(Julian.fixed(y, m+1, 1)-f) + 1
+	case d < 25:
+		r.Month, r.Event, r.Count = 3, Kalends, 30-d
+	default:
+		r.Month, r.Event, r.Count, r.Leap = 3, Kalends, 31-d, d == 25
+	}
+	return r
+}
+
+// JD returns the julian date of the midnight UTC that starts the day r.
+func (r RomanDate) JD() julian.Date {
+	y := r.Year - auc_offset
+	if r.Event == Kalends && r.Month == 1 && r.Count > 1 {
+		y++
+	}
+	var f int64
+	switch r.Event {
+	case Nones:
+		f = Julian.fixed(y, r.Month, nonesOf(r.Month))
+	case Ides:
+		f = Julian.fixed(y, r.Month, nonesOf(r.Month)+8)
+	default:
+		f = Julian.fixed(y, r.Month, 1)
+	}
+	f -= int64(r.Count)
+	// the bissextile day shifts the days from a.d. VI to XVI Kal. Mart.
+	if !(r.Event == Kalends && r.Month == 3 && Julian.IsLeapYear(y) && r.Count >= 6 && r.Count <= 16) {
+		f++
+	}
+	if r.Leap {
+		f++
+	}
+	return jdFromFixed(f)
+}
+
+// romanMonths are the abbreviations of the months in the forms that follow
+// Kal., Non. and Id.
+var romanMonths = [...]string{
+	"Ian.", "Feb.", "Mart.", "Apr.", "Mai.", "Iun.",
+	"Iul.", "Aug.", "Sept.", "Oct.", "Nov.", "Dec.",
+}
+
+// romanMonthNames are the full accusative forms of the months, against which
+// ParseRoman matches abbreviations.
+var romanMonthNames = [...]string{
+	"ianuarias", "februarias", "martias", "apriles", "maias", "iunias",
+	"iulias", "augustas", "septembres", "octobres", "novembres", "decembres",
+}
+
+// String returns the date in the abbreviated Roman style, e.g.
+// "a.d. VI Kal. Mart. AUC 2773", "prid. Id. Mart. AUC 709" or
+// "Non. Dec. AUC 691".
+func (r RomanDate) String() string {
+	var b strings.Builder
+	switch {
+	case r.Count == 2:
+		b.WriteString("prid. ")
+	case r.Count > 2:
+		b.WriteString("a.d. ")
+		if r.Leap {
+			b.WriteString("bis ")
+		}
+		b.WriteString(romanNumeral(r.Count))
+		b.WriteByte(' ')
+	}
+	b.WriteString(r.Event.String())
+	b.WriteByte(' ')
+	if r.Month >= 1 && r.Month <= 12 {
+		b.WriteString(romanMonths[r.Month-1])
+	} else {
+		b.WriteString(strconv.Itoa(r.Month))
+	}
+	b.WriteString(" AUC ")
+	b.WriteString(strconv.Itoa(r.Year))
+	return b.String()
+}
+
+// ParseRoman parses a Roman date in the form returned by RomanDate.String.
+// It also accepts the common variants of the abbreviations: the dots may be
+// left out, "pridie" or "pr." may stand for "prid.", the event and month may
+// be written out or abbreviated to at least three letters (two for Id.),
+// and J may stand for I. The year AUC is required.
+func ParseRoman(s string) (RomanDate, error) {
+	fail := func() (RomanDate, error) {
+		return RomanDate{}, fmt.Errorf("%w: %q", julian.ErrSyntax, s)
+	}
+	var toks []string
+	for _, f := range strings.Fields(strings.ToLower(s)) {
+		toks = append(toks, strings.ReplaceAll(strings.ReplaceAll(f, ".", ""), "j", "i"))
+	}
+	next := func() string {
+		if len(toks) == 0 {
+			return ""
+		}
+		t := toks[0]
+		toks = toks[1:]
+		return t
+	}
+
+	r := RomanDate{Count: 1}
+	tok := next()
+	switch tok {
+	case "ad":
+		tok = next()
+		if tok == "bis" {
+			r.Leap = true
+			tok = next()
+		}
+		n, ok := parseRomanNumeral(tok)
+		if !ok || n < 3 {
+			return fail()
+		}
+		r.Count = n
+		tok = next()
+	case "prid", "pridie", "pr":
+		r.Count = 2
+		tok = next()
+	}
+
+	switch {
+	case len(tok) >= 3 && strings.HasPrefix("kalendas", tok), tok == "kalendis":
+		r.Event = Kalends
+	case len(tok) >= 3 && strings.HasPrefix("nonas", tok), tok == "nonis":
+		r.Event = Nones
+	case len(tok) >= 2 && strings.HasPrefix("idus", tok), tok == "idibus":
+		r.Event = Ides
+	default:
+		return fail()
+	}
+
+	tok = next()
+	for i, name := range romanMonthNames {
+		if len(tok) >= 3 && strings.HasPrefix(name, tok) {
+			r.Month = i + 1
+		}
+	}
+	if r.Month == 0 {
+		return fail()
+	}
+
+	if next() != "auc" {
+		return fail()
+	}
+	year, err := strconv.Atoi(next())
+	if err != nil || len(toks) != 0 {
+		return fail()
+	}
+	r.Year = year
+
+	// reject counts that run past the previous event
+	if Roman(r.JD()) != r {
+		return fail()
+	}
+	return r, nil
+}
+
+// romanNumeral returns n in Roman numerals, for n from 1 to 3999.
+func romanNumeral(n int) string {
+	if n < 1 || n > 3999 {
+		return strconv.Itoa(n)
+	}
+	var b strings.Builder
+	for _, v := range romanValues {
+		for n >= v.n {
+			b.WriteString(v.s)
+			n -= v.n
+		}
+	}
+	return b.String()
+}
+
+var romanValues = []struct {
+	n int
+	s string
+}{
+	{1000, "M"}, {900, "CM"}, {500, "D"}, {400, "CD"}, {100, "C"}, {90, "XC"},
+	{50, "L"}, {40, "XL"}, {10, "X"}, {9, "IX"}, {5, "V"}, {4, "IV"}, {1, "I"},
+}
+
+// parseRomanNumeral parses a Roman numeral in the canonical form returned by
+// romanNumeral, in either case.
+func parseRomanNumeral(s string) (int, bool) {
+	s = strings.ToUpper(s)
+	n := 0
+	for i := 0; i < len(s); {
+		j := i
+		for _, v := range romanValues {
+			if strings.HasPrefix(s[i:], v.s) {
+				n += v.n
+				i += len(v.s)
+				break
+			}
+		}
+		if i == j {
+			return 0, false
+		}
+	}
+	if s == "" || romanNumeral(n) != s {
+		return 0, false
+	}
+	return n, true
+}
@@ -0,0 +1,96 @@
+package calendar
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/pachecot/julian"
+)
+
+func TestRoman(t *testing.T) {
+	tests := []struct {
+		year, month, day int
+		want             string
+	}{
+		{-43, 3, 15, "Id. Mart. AUC 710"},
+		{-43, 3, 14, "prid. Id. Mart. AUC 710"},
+		{2020, 1, 1, "Kal. Ian. AUC 2773"},
+		{2020, 1, 2, "a.d. IV Non. Ian. AUC 2773"},
+		{2020, 1, 5, "Non. Ian. AUC 2773"},
+		{2020, 1, 16, "a.d. XVII Kal. Feb. AUC 2773"},
+		{2019, 2, 14, "a.d. XVI Kal. Mart. AUC 2772"},
+		{2019, 2, 24, "a.d. VI Kal. Mart. AUC 2772"},
+		{2020, 2, 14, "a.d. XVI Kal. Mart. AUC 2773"},
+		{2020, 2, 24, "a.d. VI Kal. Mart. AUC 2773"},
+		{2020, 2, 25, "a.d. bis VI Kal. Mart. AUC 2773"},
+		{2020, 2, 26, "a.d. V Kal. Mart. AUC 2773"},
+		{2020, 2, 29, "prid. Kal. Mart. AUC 2773"},
+		{2020, 7, 7, "Non. Iul. AUC 2773"},
+		{2020, 12, 25, "a.d. VIII Kal. Ian. AUC 2773"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			jd := Julian.ToJD(tt.year, tt.month, tt.day)
+			r := Roman(jd + 0.5)
+			if got := r.String(); got != tt.want {
+				t.Errorf("Roman(%v) = %q, want %q", jd, got, tt.want)
+			}
+			if got := r.JD(); got != jd {
+				t.Errorf("RomanDate(%v).JD() = %v, want %v", r, got, jd)
+			}
+			p, err := ParseRoman(tt.want)
+			if err != nil || p != r {
+				t.Errorf("ParseRoman(%q) = %+v, %v, want %+v", tt.want, p, err, r)
+			}
+		})
+	}
+}
+
+func TestRoman_roundTrip(t *testing.T) {
+	for jd := julian.Date(1_000_000.5); jd < 2_600_000; jd += 97 {
+		r := Roman(jd)
+		if got := r.JD(); got != jd {
+			t.Fatalf("Roman(%v).JD() = %v (%v)", jd, got, r)
+		}
+		if p, err := ParseRoman(r.String()); err != nil || p != r {
+			t.Fatalf("ParseRoman(%q) = %+v, %v, want %+v", r, p, err, r)
+		}
+	}
+}
+
+func TestParseRoman(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{"ad iv non ian auc 2773", "a.d. IV Non. Ian. AUC 2773", false},
+		{"pridie Idus Martias a.u.c. 710", "prid. Id. Mart. AUC 710", false},
+		{"Kalendas Januarias AUC 2773", "Kal. Ian. AUC 2773", false},
+		{"a.d. XVII Kal. Sep. AUC 2773", "a.d. XVII Kal. Sept. AUC 2773", false},
+		{"a.d. XX Kal. Mart. AUC 2773", "", true},
+		{"a.d. bis VI Kal. Mart. AUC 2772", "", true},
+		{"a.d. IIII Non. Ian. AUC 2773", "", true},
+		{"a.d. II Non. Ian. AUC 2773", "", true},
+		{"Id. Mart.", "", true},
+		{"Id. Mart. AUC 710 BC", "", true},
+		{"Mart. Id. AUC 710", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseRoman(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseRoman(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			}
+			if err != nil {
+				if !errors.Is(err, julian.ErrSyntax) {
+					t.Errorf("ParseRoman(%q) error = %v, want ErrSyntax", tt.in, err)
+				}
+				return
+			}
+			if got.String() != tt.want {
+				t.Errorf("ParseRoman(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package calendar
+
+import "github.com/pachecot/julian"
+
+// SakaCalendar is the Indian National Calendar of the Calendar Reform
+// Committee, in official use since 1957. It is aligned with the Gregorian
+// calendar: the year starts on 1 Chaitra, March 22, or March 21 in a
+// Gregorian leap year, when Chaitra has 31 days instead of 30. Vaisakha (2)
+// to Bhadra (6) have 31 days and Asvina (7) to Phalguna (12) 30. Years are
+// counted in the Saka era, 78 years behind the Gregorian.
+type SakaCalendar struct{}
+
+// Saka is the Indian National Calendar.
+var Saka SakaCalendar
+
+const saka_offset = 78 // years from the Saka era to the Gregorian
+
+// IsLeapYear reports whether year has 366 days, which is when the Gregorian
+// year in which it starts is a leap year.
+func (SakaCalendar) IsLeapYear(year int) bool {
+	return Gregorian.IsLeapYear(year + saka_offset)
+}
+
+// DaysInMonth returns the number of days in the given month of year.
+func (c SakaCalendar) DaysInMonth(year, month int) int {
+	switch {
+	case month == 1 && c.IsLeapYear(year):
+		return 31
+	case month >= 2 && month <= 6:
+		return 31
+	}
+	return 30
+}
+
+// ToJD returns the julian date of the midnight UTC that starts the given
+// Saka date. The month must be in the range [1, 12]; days outside the month
+// are counted on from its first day.
+func (c SakaCalendar) ToJD(year, month, day int) julian.Date {
+	return jdFromFixed(c.fixed(year, month, day))
+}
+
+// FromJD returns the Saka date of the UTC day containing jd.
+func (c SakaCalendar) FromJD(jd julian.Date) (year, month, day int) {
+	f := fixedFromJD(jd)
+	year = Gregorian.year(f) - saka_offset
+	if f < c.newYear(year) {
+		year--
+	}
+	doy := int(f - c.newYear(year))
+	month = 1
+	for doy >= c.DaysInMonth(year, month) {
+		doy -= c.DaysInMonth(year, month)
+		month++
+	}
+	return year, month, doy + 1
+}
+
+func (c SakaCalendar) fixed(year, month, day int) int64 {
+	f := c.newYear(year) + int64(day) - 1
+	for m := 1; m < month; m++ {
+		f += int64(c.DaysInMonth(year, m))
+	}
+	return f
+}
+
+// newYear returns the fixed day of 1 Chaitra of year.
+func (c SakaCalendar) newYear(year int) int64 {
+	if c.IsLeapYear(year) {
+		return Gregorian.fixed(year+saka_offset, 3, 21)
+	}
+	return Gregorian.fixed(year+saka_offset, 3, 22)
+}
+
+// Name returns the name of the calendar, "Saka".
+func (SakaCalendar) Name() string { return "Saka" }
+
+// ToJDN returns the julian day number of the date.
+func (c SakaCalendar) ToJDN(f Fields) int64 { return toJDN(c.fixed(f.Year, f.Month, f.Day)) }
+
+// FromJDN returns the date of the day with the julian day number jdn.
+func (c SakaCalendar) FromJDN(jdn int64) Fields {
+	y, m, d := c.FromJD(jdFromFixed(fromJDN(jdn)))
+	return Fields{y, m, d}
+}
+
+// MonthsInYear returns 12.
+func (SakaCalendar) MonthsInYear(year int) int { return 12 }
+
+// MonthName returns the name of the month, e.g. "Chaitra".
+func (SakaCalendar) MonthName(year, month int) string {
+	return monthName(sakaMonths[:], month)
+}
+
+var sakaMonths = [...]string{
+	"Chaitra", "Vaisakha", "Jyaishtha", "Asadha", "Sravana", "Bhadra",
+	"Asvina", "Kartika", "Agrahayana", "Pausa", "Magha", "Phalguna",
+}
+
+// Era returns "SE", the Saka Era.
+func (SakaCalendar) Era() string { return "SE" }
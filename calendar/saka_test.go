@@ -0,0 +1,47 @@
+package calendar
+
+import (
+	"testing"
+
+	"github.com/pachecot/julian"
+)
+
+func TestSaka(t *testing.T) {
+	tests := []struct {
+		name             string
+		jd               julian.Date
+		year, month, day int
+	}{
+		{"adoption", Gregorian.ToJD(1957, 3, 22), 1879, 1, 1},
+		{"new year 1945", Gregorian.ToJD(2023, 3, 22), 1945, 1, 1},
+		{"Republic Day", Gregorian.ToJD(2024, 1, 26), 1945, 11, 6},
+		{"last day 1945", Gregorian.ToJD(2024, 3, 20), 1945, 12, 30},
+		{"new year 1946", Gregorian.ToJD(2024, 3, 21), 1946, 1, 1},
+		{"Chaitra 31", Gregorian.ToJD(2024, 4, 20), 1946, 1, 31},
+		{"Vaisakha", Gregorian.ToJD(2024, 4, 21), 1946, 2, 1},
+		{"Independence Day", Gregorian.ToJD(2024, 8, 15), 1946, 5, 24},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Saka.ToJD(tt.year, tt.month, tt.day); got != tt.jd {
+				t.Errorf("Saka.ToJD(%v, %v, %v) = %v, want %v", tt.year, tt.month, tt.day, got, tt.jd)
+			}
+			y, m, d := Saka.FromJD(tt.jd + 0.5)
+			if y != tt.year || m != tt.month || d != tt.day {
+				t.Errorf("Saka.FromJD(%v) = %v, %v, %v, want %v, %v, %v", tt.jd+0.5, y, m, d, tt.year, tt.month, tt.day)
+			}
+		})
+	}
+}
+
+func TestSaka_roundTrip(t *testing.T) {
+	for jd := julian.Date(-800_000.5); jd < 4_000_000; jd += 997 {
+		y, m, d := Saka.FromJD(jd)
+		if got := Saka.ToJD(y, m, d); got != jd {
+			t.Fatalf("Saka.ToJD(Saka.FromJD(%v)) = %v (%v, %v, %v)", jd, got, y, m, d)
+		}
+		if m < 1 || m > 12 || d < 1 || d > Saka.DaysInMonth(y, m) {
+			t.Fatalf("Saka.FromJD(%v) = %v, %v, %v, out of range", jd, y, m, d)
+		}
+	}
+}
@@ -0,0 +1,528 @@
+package julian
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestJulianDate_Weekday(t *testing.T) {
+	tests := []struct {
+		name string
+		jd   Date
+		want time.Weekday
+	}{
+		{"J2000", Date(2_451_545.0), time.Saturday},
+		{"J2000 midnight", Date(2_451_544.5), time.Saturday},
+		{"before midnight", Date(2_451_545.49), time.Saturday},
+		{"after midnight", Date(2_451_545.5), time.Sunday},
+		{"Feb. 14, 2010", Time(time.Date(2010, time.February, 14, 5, 21, 0, 0, time.UTC)), time.Sunday},
+		{"JD 0", Date(0), time.Monday},
+		{"negative", Date(-1), time.Sunday},
+		{"far future", Date(1_000_000_000), time.Weekday((1_000_000_000 + 1) % 7)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.jd.Weekday(); got != tt.want {
+				t.Errorf("JulianDate.Weekday() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJulianDate_Date(t *testing.T) {
+	type want struct {
+		year  int
+		month time.Month
+		day   int
+	}
+	tests := []struct {
+		name string
+		jd   Date
+		want want
+	}{
+		{"J2000", Date(2_451_545.0), want{2000, time.January, 1}},
+		{"J2000 midnight", Date(2_451_544.5), want{2000, time.January, 1}},
+		{"before midnight", Date(2_451_544.49), want{1999, time.December, 31}},
+		{"leap day", Time(time.Date(2020, 2, 29, 23, 59, 0, 0, time.UTC)), want{2020, time.February, 29}},
+		{"Gregorian reform", Date(2_299_160.5), want{1582, time.October, 15}},
+		{"year 1", Date(1_721_425.5), want{1, time.January, 1}},
+		{"year 0", Date(1_721_424.5), want{0, time.December, 31}},
+		{"JD 0", Date(0), want{-4713, time.November, 24}},
+		{"Ides of March 44 BC", Date(1_705_425.5), want{-43, time.March, 13}},
+		{"negative JD", Date(-365), want{-4714, time.November, 24}},
+		{"far future", Date(5_373_484.5), want{10000, time.January, 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			y, m, d := tt.jd.Date()
+			if got := (want{y, m, d}); got != tt.want {
+				t.Errorf("JulianDate.Date() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDaysFromCivil(t *testing.T) {
+	for days := int64(-1_000_000); days <= 1_000_000; days += 97 {
+		y, m, d := civilFromDays(days)
+		if got := daysFromCivil(y, m, d); got != days {
+			t.Fatalf("daysFromCivil(civilFromDays(%d)) = %d", days, got)
+		}
+		want := time.Unix(days*day_seconds, 0).UTC()
+		if wy, wm, wd := want.Date(); y != wy || m != wm || d != wd {
+			t.Fatalf("civilFromDays(%d) = %d-%d-%d, want %v", days, y, m, d, want)
+		}
+	}
+}
+
+func TestJulianDate_Clock(t *testing.T) {
+	type want struct {
+		hour, min, sec int
+	}
+	tests := []struct {
+		name string
+		jd   Date
+		want want
+	}{
+		{"noon", Date(2_451_545.0), want{12, 0, 0}},
+		{"midnight", Date(2_451_544.5), want{0, 0, 0}},
+		{"evening", Date(2_451_545.25), want{18, 0, 0}},
+		{"Feb. 14, 2010 5:21", Time(time.Date(2010, time.February, 14, 5, 21, 0, 0, time.UTC)), want{5, 21, 0}},
+		{"seconds", Time(time.Date(2010, time.February, 14, 23, 59, 59, 0, time.UTC)), want{23, 59, 59}},
+		{"negative", Date(-0.25), want{6, 0, 0}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, m, s := tt.jd.Clock()
+			if got := (want{h, m, s}); got != tt.want {
+				t.Errorf("JulianDate.Clock() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJulianDate_Nanosecond(t *testing.T) {
+	tests := []struct {
+		name string
+		jd   Date
+		want int
+	}{
+		{"whole", Date(2_451_545.0), 0},
+		{"half", Time(time.Date(2010, time.February, 14, 5, 21, 0, 500_000_000, time.UTC)), 500_000_000},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.jd.Nanosecond(); got-tt.want > 50000 || tt.want-got > 50000 {
+				t.Errorf("JulianDate.Nanosecond() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJulianDate_YearDay(t *testing.T) {
+	tests := []struct {
+		name string
+		jd   Date
+		want int
+	}{
+		{"J2000", Date(2_451_545.0), 1},
+		{"Feb. 14, 2010", Time(time.Date(2010, time.February, 14, 5, 21, 0, 0, time.UTC)), 45},
+		{"Dec. 31, 2019", Time(time.Date(2019, time.December, 31, 0, 0, 0, 0, time.UTC)), 365},
+		{"Dec. 31, 2020", Time(time.Date(2020, time.December, 31, 23, 0, 0, 0, time.UTC)), 366},
+		{"Mar. 1, 1900", Time(time.Date(1900, time.March, 1, 0, 0, 0, 0, time.UTC)), 60},
+		{"JD 0", Date(0), 328},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.jd.YearDay(); got != tt.want {
+				t.Errorf("JulianDate.YearDay() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJulianDate_ISOWeek(t *testing.T) {
+	type want struct {
+		year, week int
+	}
+	tests := []struct {
+		name string
+		jd   Date
+		want want
+	}{
+		{"J2000", Date(2_451_545.0), want{1999, 52}},
+		{"Jan. 3, 2000", Time(time.Date(2000, 1, 3, 0, 0, 0, 0, time.UTC)), want{2000, 1}},
+		{"Dec. 29, 2008", Time(time.Date(2008, 12, 29, 0, 0, 0, 0, time.UTC)), want{2009, 1}},
+		{"Dec. 31, 2020", Time(time.Date(2020, 12, 31, 0, 0, 0, 0, time.UTC)), want{2020, 53}},
+		{"Jan. 3, 2021", Time(time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC)), want{2020, 53}},
+		{"Feb. 14, 2010", Time(time.Date(2010, time.February, 14, 5, 21, 0, 0, time.UTC)), want{2010, 6}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			y, w := tt.jd.ISOWeek()
+			if got := (want{y, w}); got != tt.want {
+				t.Errorf("JulianDate.ISOWeek() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJulianDate_ISOWeek_time(t *testing.T) {
+	for days := int64(-800_000); days <= 800_000; days += 3 {
+		tm := time.Unix(days*day_seconds, 0).UTC()
+		wy, ww := tm.ISOWeek()
+		if y, w := Time(tm).ISOWeek(); y != wy || w != ww {
+			t.Fatalf("JulianDate.ISOWeek() of %v = %d-W%d, want %d-W%d", tm, y, w, wy, ww)
+		}
+	}
+}
+
+func TestFromOrdinal(t *testing.T) {
+	tests := []struct {
+		name      string
+		year, doy int
+		frac      float64
+		want      Date
+	}{
+		{"J2000", 2000, 1, 0.5, 2_451_545},
+		{"leap day", 2000, 60, 0, Time(time.Date(2000, 2, 29, 0, 0, 0, 0, time.UTC))},
+		{"Dec 31 leap", 2020, 366, 0.25, Time(time.Date(2020, 12, 31, 6, 0, 0, 0, time.UTC))},
+		{"next year", 2021, 366, 0, Time(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC))},
+		{"JD 0", -4713, 328, 0.5, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FromOrdinal(tt.year, tt.doy, tt.frac)
+			if !equalJulian(got, tt.want) {
+				t.Errorf("FromOrdinal(%v, %v, %v) = %v, want %v", tt.year, tt.doy, tt.frac, got, tt.want)
+			}
+			if tt.name == "next year" {
+				return
+			}
+			if y, d := got.Ordinal(); y != tt.year || d != tt.doy {
+				t.Errorf("JulianDate.Ordinal() = %v, %v, want %v, %v", y, d, tt.year, tt.doy)
+			}
+		})
+	}
+}
+
+func TestFromISOWeekDate(t *testing.T) {
+	tests := []struct {
+		name                string
+		year, week, weekday int
+		want                time.Time
+	}{
+		{"2009-W01-1", 2009, 1, 1, time.Date(2008, 12, 29, 0, 0, 0, 0, time.UTC)},
+		{"2020-W53-7", 2020, 53, 7, time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC)},
+		{"2000-W01-1", 2000, 1, 1, time.Date(2000, 1, 3, 0, 0, 0, 0, time.UTC)},
+		{"2004-W53-6", 2004, 53, 6, time.Date(2005, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"2021-W00-7", 2021, 0, 7, time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FromISOWeekDate(tt.year, tt.week, tt.weekday); got != Time(tt.want) {
+				t.Errorf("FromISOWeekDate(%v, %v, %v) = %v, want %v", tt.year, tt.week, tt.weekday, got, Time(tt.want))
+			}
+		})
+	}
+}
+
+func TestJulianDate_ISOWeekDate(t *testing.T) {
+	for days := int64(-800_000); days <= 800_000; days += 3 {
+		tm := time.Unix(days*day_seconds, 0).UTC()
+		wy, ww := tm.ISOWeek()
+		wd := (int(tm.Weekday())+6)%7 + 1
+		jd := Time(tm)
+		if y, w, d := (jd + 0.25).ISOWeekDate(); y != wy || w != ww || d != wd {
+			t.Fatalf("JulianDate.ISOWeekDate() of %v = %d-W%d-%d, want %d-W%d-%d", tm, y, w, d, wy, ww, wd)
+		}
+		if got := FromISOWeekDate(wy, ww, wd); got != jd {
+			t.Fatalf("FromISOWeekDate(%d, %d, %d) = %v, want %v", wy, ww, wd, got, jd)
+		}
+	}
+}
+
+func TestJulianDate_Quarter(t *testing.T) {
+	tests := []struct {
+		name          string
+		jd            Date
+		quarter, half int
+	}{
+		{"Jan", Time(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)), 1, 1},
+		{"Mar", Time(time.Date(2020, 3, 31, 23, 0, 0, 0, time.UTC)), 1, 1},
+		{"Apr", Time(time.Date(2020, 4, 1, 0, 0, 0, 0, time.UTC)), 2, 1},
+		{"Jul", Time(time.Date(2020, 7, 1, 0, 0, 0, 0, time.UTC)), 3, 2},
+		{"Dec", Time(time.Date(2020, 12, 31, 0, 0, 0, 0, time.UTC)), 4, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.jd.Quarter(); got != tt.quarter {
+				t.Errorf("JulianDate.Quarter() = %v, want %v", got, tt.quarter)
+			}
+			if got := tt.jd.Half(); got != tt.half {
+				t.Errorf("JulianDate.Half() = %v, want %v", got, tt.half)
+			}
+		})
+	}
+}
+
+func TestJulianDate_FiscalQuarter(t *testing.T) {
+	type want struct {
+		year, quarter int
+	}
+	tests := []struct {
+		name  string
+		jd    Date
+		start time.Month
+		want  want
+	}{
+		{"calendar", Time(time.Date(2020, 5, 1, 0, 0, 0, 0, time.UTC)), time.January, want{2020, 2}},
+		{"October start", Time(time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)), time.October, want{2024, 1}},
+		{"October start Sep", Time(time.Date(2024, 9, 30, 0, 0, 0, 0, time.UTC)), time.October, want{2024, 4}},
+		{"April start Mar", Time(time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC)), time.April, want{2024, 4}},
+		{"April start Apr", Time(time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)), time.April, want{2025, 1}},
+		{"July start Dec", Time(time.Date(2024, 12, 15, 0, 0, 0, 0, time.UTC)), time.July, want{2025, 2}},
+		{"normalized start", Time(time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)), 22, want{2024, 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			y, q := tt.jd.FiscalQuarter(tt.start)
+			if got := (want{y, q}); got != tt.want {
+				t.Errorf("JulianDate.FiscalQuarter() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJulianDate_NextWeekday(t *testing.T) {
+	sat := Date(2_451_545.25) // Saturday, January 1, 2000 18:00 UTC
+	tests := []struct {
+		name string
+		w    time.Weekday
+		want Date
+	}{
+		{"Sunday", time.Sunday, sat + 1},
+		{"Friday", time.Friday, sat + 6},
+		{"Saturday", time.Saturday, sat + 7},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := sat.NextWeekday(tt.w)
+			if got != tt.want {
+				t.Errorf("JulianDate.NextWeekday() = %f, want %f", got, tt.want)
+			}
+			if got.Weekday() != tt.w {
+				t.Errorf("JulianDate.NextWeekday().Weekday() = %v, want %v", got.Weekday(), tt.w)
+			}
+		})
+	}
+}
+
+func TestJulianDate_PreviousWeekday(t *testing.T) {
+	sat := Date(2_451_545.25) // Saturday, January 1, 2000 18:00 UTC
+	tests := []struct {
+		name string
+		w    time.Weekday
+		want Date
+	}{
+		{"Friday", time.Friday, sat - 1},
+		{"Sunday", time.Sunday, sat - 6},
+		{"Saturday", time.Saturday, sat - 7},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := sat.PreviousWeekday(tt.w)
+			if got != tt.want {
+				t.Errorf("JulianDate.PreviousWeekday() = %f, want %f", got, tt.want)
+			}
+			if got.Weekday() != tt.w {
+				t.Errorf("JulianDate.PreviousWeekday().Weekday() = %v, want %v", got.Weekday(), tt.w)
+			}
+		})
+	}
+}
+
+func TestJulianDate_DecimalYear(t *testing.T) {
+	tests := []struct {
+		name string
+		jd   Date
+		want float64
+	}{
+		{"Jan. 1, 2000", Time(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)), 2000},
+		{"mid 2021", Time(time.Date(2021, 7, 2, 12, 0, 0, 0, time.UTC)), 2021.5},
+		{"mid 2020", Time(time.Date(2020, 7, 2, 0, 0, 0, 0, time.UTC)), 2020.5},
+		{"end 2019", Time(time.Date(2019, 12, 31, 0, 0, 0, 0, time.UTC)), 2019 + 364.0/365},
+		{"year 0", Date(1_721_425.5 - 366), 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.jd.DecimalYear()
+			if math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("JulianDate.DecimalYear() = %v, want %v", got, tt.want)
+			}
+			if back := FromDecimalYear(got); !equalJulian(back, tt.jd) {
+				t.Errorf("FromDecimalYear(%v) = %f, want %f", got, back, tt.jd)
+			}
+		})
+	}
+}
+
+func TestJulianDate_DateIn(t *testing.T) {
+	type want struct {
+		year  int
+		month time.Month
+		day   int
+	}
+	tests := []struct {
+		name string
+		jd   Date
+		cs   CalendarSystem
+		want want
+	}{
+		{"Gregorian reform", Date(2_299_160.5), ProlepticGregorian, want{1582, time.October, 15}},
+		{"day before reform", Date(2_299_159.5), ProlepticJulian, want{1582, time.October, 4}},
+		{"Ides of March 44 BC", Date(1_705_425.5), ProlepticJulian, want{-43, time.March, 15}},
+		{"J2000", Date(2_451_545.0), ProlepticJulian, want{1999, time.December, 19}},
+		{"JD 0", Date(0), ProlepticJulian, want{-4712, time.January, 1}},
+		{"year 1", Date(1_721_423.5), ProlepticJulian, want{1, time.January, 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			y, m, d := tt.jd.DateIn(tt.cs)
+			if got := (want{y, m, d}); got != tt.want {
+				t.Errorf("JulianDate.DateIn(%v) = %v, want %v", tt.cs, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDaysFromJulian(t *testing.T) {
+	for days := int64(-3_000_000); days <= 1_000_000; days += 89 {
+		y, m, d := julianFromDays(days)
+		if got := daysFromJulian(y, m, d); got != days {
+			t.Fatalf("daysFromJulian(julianFromDays(%d)) = %d", days, got)
+		}
+		if d < 1 || d > daysIn(m, y, ProlepticJulian) {
+			t.Fatalf("julianFromDays(%d) = %d-%d-%d, invalid day", days, y, m, d)
+		}
+	}
+}
+
+func TestJulianDate_JulianPeriod(t *testing.T) {
+	tests := []struct {
+		name   string
+		jd     Date
+		period int
+		year   int
+	}{
+		{"JD 0", Date(0), 1, 1},
+		{"end of 4713 BC", Date(365.4), 1, 1},
+		{"4712 BC", Date(366), 1, 2},
+		{"before JD 0", Date(-1), 0, 7980},
+		{"AD 1", Date(1721423.5), 1, 4714},
+		{"2024", Time(time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)), 1, 6737},
+		{"Julian AD 3267", Date(2914694.4), 1, 7980},
+		{"Julian AD 3268", Date(2914694.5), 2, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			period, year := tt.jd.JulianPeriod()
+			if period != tt.period || year != tt.year {
+				t.Errorf("JulianDate.JulianPeriod() = %v, %v, want %v, %v", period, year, tt.period, tt.year)
+			}
+		})
+	}
+}
+
+func TestJulianDate_IsLeapYear(t *testing.T) {
+	tests := []struct {
+		name      string
+		jd        Date
+		gregorian bool
+		julian    bool
+	}{
+		{"2000", Time(time.Date(2000, 6, 1, 0, 0, 0, 0, time.UTC)), true, true},
+		{"1900", Time(time.Date(1900, 6, 1, 0, 0, 0, 0, time.UTC)), false, true},
+		{"2019", Time(time.Date(2019, 6, 1, 0, 0, 0, 0, time.UTC)), false, false},
+		{"2024", Time(time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)), true, true},
+		{"JD 0", Date(0), false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.jd.IsLeapYear(); got != tt.gregorian {
+				t.Errorf("JulianDate.IsLeapYear() = %v, want %v", got, tt.gregorian)
+			}
+			if got := tt.jd.IsLeapYearIn(ProlepticJulian); got != tt.julian {
+				t.Errorf("JulianDate.IsLeapYearIn(ProlepticJulian) = %v, want %v", got, tt.julian)
+			}
+		})
+	}
+}
+
+func TestJulianDate_DaysInMonth(t *testing.T) {
+	tests := []struct {
+		name      string
+		jd        Date
+		gregorian int
+		julian    int
+	}{
+		{"Jan 2000", Time(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)), 31, 31},
+		{"Feb 2000", Time(time.Date(2000, 2, 20, 0, 0, 0, 0, time.UTC)), 29, 29},
+		{"Feb 1900", Time(time.Date(1900, 2, 20, 0, 0, 0, 0, time.UTC)), 28, 29},
+		{"Apr 2021", Time(time.Date(2021, 4, 20, 0, 0, 0, 0, time.UTC)), 30, 30},
+		{"Mar 1 1900", Time(time.Date(1900, 3, 1, 0, 0, 0, 0, time.UTC)), 31, 29},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.jd.DaysInMonth(); got != tt.gregorian {
+				t.Errorf("JulianDate.DaysInMonth() = %v, want %v", got, tt.gregorian)
+			}
+			if got := tt.jd.DaysInMonthIn(ProlepticJulian); got != tt.julian {
+				t.Errorf("JulianDate.DaysInMonthIn(ProlepticJulian) = %v, want %v", got, tt.julian)
+			}
+		})
+	}
+}
+
+func TestJulianDate_WithClock(t *testing.T) {
+	jd := Time(time.Date(2010, time.February, 14, 5, 21, 0, 0, time.UTC))
+	tests := []struct {
+		name                 string
+		hour, min, sec, nsec int
+		want                 time.Time
+	}{
+		{"03:00", 3, 0, 0, 0, time.Date(2010, time.February, 14, 3, 0, 0, 0, time.UTC)},
+		{"midnight", 0, 0, 0, 0, time.Date(2010, time.February, 14, 0, 0, 0, 0, time.UTC)},
+		{"evening", 23, 59, 59, 500_000_000, time.Date(2010, time.February, 14, 23, 59, 59, 500_000_000, time.UTC)},
+		{"normalized", 24, 0, 0, 0, time.Date(2010, time.February, 15, 0, 0, 0, 0, time.UTC)},
+		{"negative", 0, -30, 0, 0, time.Date(2010, time.February, 13, 23, 30, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := jd.WithClock(tt.hour, tt.min, tt.sec, tt.nsec); !equalJulian(got, Time(tt.want)) {
+				t.Errorf("JulianDate.WithClock() = %v, want %v", got.UTC(), tt.want)
+			}
+		})
+	}
+}
+
+func TestJulianDate_WithFraction(t *testing.T) {
+	tests := []struct {
+		name string
+		jd   Date
+		frac float64
+		want Date
+	}{
+		{"evening", Date(2_451_545.75), 0.25, Date(2_451_545.25)},
+		{"noon", Date(2_451_545.75), 0, Date(2_451_545.0)},
+		{"negative", Date(-0.5), 0.25, Date(-0.75)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.jd.WithFraction(tt.frac); got != tt.want {
+				t.Errorf("JulianDate.WithFraction() = %f, want %f", got, tt.want)
+			}
+		})
+	}
+}
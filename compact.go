@@ -0,0 +1,43 @@
+package julian
+
+import "time"
+
+// A CompactDate is a julian date stored as the number of microseconds since
+// J2000.0, noon UTC on January 1, 2000. It is a fixed-width integer form for
+// columnar storage and wire protocols, and spans about 292,000 years either
+// side of its epoch.
+//
+// CompactTime and Gregorian round-trip a time.Time exactly at microsecond
+// resolution. Conversions by way of Date are not exact: a Date resolves only
+// about 20 µs near the present, and less farther from JD 0, so neither
+// CompactDate to Date and back nor Date to CompactDate and back is lossless
+// in general.
+type CompactDate int64
+
+// unix_micro_j2000 is the Unix time of J2000.0 in microseconds.
+const unix_micro_j2000 = 946_728_000 * 1_000_000
+
+// Compact returns jd as a CompactDate, rounded to the nearest microsecond.
+func (jd Date) Compact() CompactDate {
+	days, nsec := jd.civil()
+	return CompactDate(days*day_seconds*1e6 + (nsec+500)/1e3 - unix_micro_j2000)
+}
+
+// CompactTime returns the time t as a CompactDate, rounded to the nearest
+// microsecond as Compact does.
+func CompactTime(t time.Time) CompactDate {
+	us := t.Unix()*1e6 + int64(t.Nanosecond()+500)/1e3
+	return CompactDate(us - unix_micro_j2000)
+}
+
+// Date returns c as a julian date.
+func (c CompactDate) Date() Date {
+	us := int64(c) + unix_micro_j2000
+	days := floorDiv(us, day_seconds*1e6)
+	return fromCivil(days, (us-days*day_seconds*1e6)*1e3)
+}
+
+// Gregorian returns c as a time.Time in the local time zone.
+func (c CompactDate) Gregorian() time.Time {
+	return time.UnixMicro(int64(c) + unix_micro_j2000)
+}
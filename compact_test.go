@@ -0,0 +1,81 @@
+package julian
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestCompactTime(t *testing.T) {
+	tests := []struct {
+		name string
+		t    time.Time
+		want CompactDate
+	}{
+		{"J2000", time.Date(2000, time.January, 1, 12, 0, 0, 0, time.UTC), 0},
+		{"microsecond", time.Date(2000, time.January, 1, 12, 0, 0, 1_499, time.UTC), 1},
+		{"rounds up", time.Date(2000, time.January, 1, 12, 0, 0, 1_500, time.UTC), 2},
+		{"rounds up before", time.Date(2000, time.January, 1, 11, 59, 59, 999_999_600, time.UTC), 0},
+		{"before", time.Date(2000, time.January, 1, 11, 59, 59, 999_999_000, time.UTC), -1},
+		{"unix", time.Unix(0, 0), -unix_micro_j2000},
+		{"year 1000", time.Date(1000, time.January, 1, 12, 0, 0, 0, time.UTC), -365_242 * day_seconds * 1e6},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := CompactTime(tt.t)
+			if got != tt.want {
+				t.Errorf("CompactTime() = %d, want %d", got, tt.want)
+			}
+			if back := got.Gregorian(); !back.Equal(tt.t.Round(time.Microsecond)) {
+				t.Errorf("CompactDate.Gregorian() = %v, want %v", back, tt.t)
+			}
+		})
+	}
+}
+
+func TestCompactTime_agreesWithCompact(t *testing.T) {
+	// the dates J2000 + k/2^31 days are exact float64 values, so both paths
+	// see the same instant, to within the nanosecond of the time.Time, and
+	// must round it to the same microsecond
+	j2000 := time.Date(2000, time.January, 1, 12, 0, 0, 0, time.UTC)
+	const step = float64(day_nanoseconds) / (1 << 31) // ns
+	for k := -1000; k <= 1000; k++ {
+		ns := float64(k) * step
+		if _, f := math.Modf(math.Abs(ns) / 1e3); math.Abs(f-0.5) < 0.002 {
+			continue // too near a half microsecond to compare
+		}
+		tm := j2000.Add(time.Duration(math.Round(ns)))
+		jd := Date(2_451_545) + Date(float64(k)/(1<<31))
+		if got, want := CompactTime(tm), jd.Compact(); got != want {
+			t.Fatalf("CompactTime(%v) = %d, want Compact() = %d", tm, got, want)
+		}
+	}
+}
+
+func TestJulianDate_Compact(t *testing.T) {
+	tests := []struct {
+		name string
+		jd   Date
+		want CompactDate
+	}{
+		{"J2000", Date(2_451_545), 0},
+		{"day later", Date(2_451_546), day_seconds * 1e6},
+		{"midnight before", Date(2_451_544.5), -day_seconds * 1e6 / 2},
+		{"quarter day", Date(2_451_545.25), 6 * 3600 * 1e6},
+		{"before 1678", Date(2_000_000.5), -451_544*day_seconds*1e6 - day_seconds*1e6/2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.jd.Compact()
+			if got != tt.want {
+				t.Errorf("JulianDate.Compact() = %d, want %d", got, tt.want)
+			}
+			if back := got.Date(); !equalJulian(back, tt.jd) {
+				t.Errorf("CompactDate.Date() = %v, want %v", back, tt.jd)
+			}
+			if back := got.Date().Compact(); back != got {
+				t.Errorf("CompactDate.Date().Compact() = %d, want %d", back, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package julian
+
+import (
+	"math"
+	"time"
+)
+
+// A Date2 is a julian date stored in two parts, JD1+JD2, in the style of the
+// SOFA and ERFA libraries. A single float64 resolves a modern julian date to
+// about 20 µs; splitting the whole days from the fraction preserves
+// sub-nanosecond resolution.
+//
+// Any split of the julian date is accepted. Values returned by this package
+// are normalized so that JD1 is the integral julian day, which begins at noon
+// UTC, and JD2 is the fraction of it in [0, 1).
+type Date2 struct {
+	JD1, JD2 float64
+}
+
+// Time2 returns the two-part julian date of the time t. The result is exact
+// to well under a nanosecond.
+func Time2(t time.Time) Date2 {
+	sec := t.Unix()
+	days := floorDiv(sec, day_seconds)
+	nsec := (sec-days*day_seconds)*1e9 + int64(t.Nanosecond()) - day_nanoseconds/2
+	if nsec < 0 {
+		days--
+		nsec += day_nanoseconds
+	}
+	return Date2{float64(days + jdn_unix), float64(nsec) / day_nanoseconds}
+}
+
+// Date2 returns jd as a two-part julian date.
+func (jd Date) Date2() Date2 {
+	day, frac := jd.Split()
+	return Date2{float64(day), frac}
+}
+
+// JD returns the two-part date as a single julian date, with the loss of
+// precision that implies.
+func (d Date2) JD() Date {
+	return Date(d.JD1 + d.JD2)
+}
+
+// Normalize returns d with JD1 the integral julian day and JD2 the fraction
+// of that day in [0, 1).
+func (d Date2) Normalize() Date2 {
+	i := math.Floor(d.JD1)
+	f := d.JD1 - i + d.JD2
+	c := math.Floor(f)
+	f -= c
+	if f >= 1 {
+		c, f = c+1, 0
+	}
+	return Date2{i + c, f}
+}
+
+// split returns the julian day of d and the nanoseconds since the noon that
+// begins it.
+func (d Date2) split() (day int64, nsec int64) {
+	n := d.Normalize()
+	day = int64(n.JD1)
+	nsec = int64(math.Round(n.JD2 * day_nanoseconds))
+	if nsec >= day_nanoseconds {
+		day++
+		nsec -= day_nanoseconds
+	}
+	return day, nsec
+}
+
+// Gregorian returns the two-part date as a time.Time in the local time zone.
+func (d Date2) Gregorian() time.Time {
+	day, nsec := d.split()
+	nsec += day_nanoseconds / 2
+	sec := (day-jdn_unix)*day_seconds + nsec/1e9
+	return time.Unix(sec, nsec%1e9)
+}
+
+// UTC returns the two-part date as a time.Time in UTC.
+func (d Date2) UTC() time.Time {
+	return d.Gregorian().UTC()
+}
+
+// UnixNano returns the two-part date as a Unix time, the number of
+// nanoseconds elapsed since January 1, 1970 UTC. The result is undefined if
+// it cannot be represented by an int64.
+func (d Date2) UnixNano() int64 {
+	day, nsec := d.split()
+	return (day-jdn_unix)*day_nanoseconds + nsec + day_nanoseconds/2
+}
+
+// Add returns the two-part date d+dur.
+func (d Date2) Add(dur time.Duration) Date2 {
+	days := int64(dur) / day_nanoseconds
+	rem := int64(dur) - days*day_nanoseconds
+	return Date2{d.JD1 + float64(days), d.JD2 + float64(rem)/day_nanoseconds}.Normalize()
+}
+
+// Sub returns the duration d-other. If the result exceeds the maximum (or
+// minimum) value that can be stored in a Duration, the maximum (or minimum)
+// duration will be returned.
+func (d Date2) Sub(other Date2) time.Duration {
+	a, b := d.Normalize(), other.Normalize()
+	days := a.JD1 - b.JD1
+	frac := a.JD2 - b.JD2
+	if math.Abs(days) > math.MaxInt64/day_nanoseconds+1 {
+		return Days(days + frac)
+	}
+	// JDTime.Sub saturates the sum of the days and the fraction
+	return JDTime{int64(days), int64(math.Round(frac * day_nanoseconds))}.Sub(JDTime{})
+}
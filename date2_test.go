@@ -0,0 +1,128 @@
+package julian
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestTime2(t *testing.T) {
+	tests := []struct {
+		name string
+		t    time.Time
+		want Date2
+	}{
+		{"J2000", time.Date(2000, time.January, 1, 12, 0, 0, 0, time.UTC), Date2{2_451_545, 0}},
+		{"morning", time.Date(2000, time.January, 1, 6, 0, 0, 0, time.UTC), Date2{2_451_544, 0.75}},
+		{"unix", time.Unix(0, 0), Date2{2_440_587, 0.5}},
+		{"before 1678", time.Date(1000, time.January, 1, 18, 0, 0, 0, time.UTC), Date2{2_086_303, 0.25}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Time2(tt.t)
+			if got != tt.want {
+				t.Errorf("Time2() = %v, want %v", got, tt.want)
+			}
+			if back := got.UTC(); !back.Equal(tt.t) {
+				t.Errorf("Date2.UTC() = %v, want %v", back, tt.t)
+			}
+		})
+	}
+}
+
+func TestDate2_RoundTrip(t *testing.T) {
+	start := time.Date(2024, time.March, 10, 1, 2, 3, 0, time.UTC)
+	for _, ns := range []int{1, 7, 123_456_789, 999_999_999} {
+		tm := start.Add(time.Duration(ns))
+		d := Time2(tm)
+		if got := d.UTC(); !got.Equal(tm) {
+			t.Errorf("Time2(%v).UTC() = %v", tm, got)
+		}
+		if got := d.UnixNano(); got != tm.UnixNano() {
+			t.Errorf("Time2(%v).UnixNano() = %d, want %d", tm, got, tm.UnixNano())
+		}
+	}
+}
+
+func TestDate2_Normalize(t *testing.T) {
+	tests := []struct {
+		name string
+		d    Date2
+		want Date2
+	}{
+		{"normal", Date2{2_451_545, 0.25}, Date2{2_451_545, 0.25}},
+		{"mjd split", Date2{2_400_000.5, 51_544.5}, Date2{2_451_545, 0}},
+		{"negative fraction", Date2{2_451_545, -0.25}, Date2{2_451_544, 0.75}},
+		{"fraction over one", Date2{2_451_545, 1.5}, Date2{2_451_546, 0.5}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.d.Normalize(); got != tt.want {
+				t.Errorf("Date2.Normalize() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDate2_SubLimits(t *testing.T) {
+	// the maximum duration is about 106751.9912 days
+	const limit = math.MaxInt64 / day_nanoseconds
+	tests := []struct {
+		name string
+		d    Date2
+		want time.Duration
+	}{
+		{"whole days", Date2{limit, 0}, limit * day_nanoseconds},
+		{"almost a day more", Date2{limit, 0.9}, limit*day_nanoseconds + 9*day_nanoseconds/10},
+		{"fraction that fits", Date2{limit, 0.99}, limit*day_nanoseconds + 99*day_nanoseconds/100},
+		{"overflow by the fraction", Date2{limit, 0.995}, math.MaxInt64},
+		{"day after limit", Date2{limit + 1, 0}, math.MaxInt64},
+		{"far", Date2{1e9, 0}, math.MaxInt64},
+		{"negative overflow by the fraction", Date2{-limit - 1, 0.005}, math.MinInt64},
+		{"negative almost a day more", Date2{-limit - 1, 0.1}, -limit*day_nanoseconds - 9*day_nanoseconds/10},
+		{"negative far", Date2{-1e9, 0}, math.MinInt64},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.d.Sub(Date2{}); got != tt.want {
+				t.Errorf("%v.Sub(Date2{}) = %v, want %v", tt.d, int64(got), int64(tt.want))
+			}
+		})
+	}
+}
+
+func TestDate2_AddSub(t *testing.T) {
+	d := Date2{2_451_545, 0.25}
+	tests := []struct {
+		name string
+		dur  time.Duration
+		want Date2
+	}{
+		{"hours", 6 * time.Hour, Date2{2_451_545, 0.5}},
+		{"carry", 20 * time.Hour, Date2{2_451_546, 0.25 + 20.0/24 - 1}},
+		{"negative", -12 * time.Hour, Date2{2_451_544, 0.75}},
+		{"days", 48 * time.Hour, Date2{2_451_547, 0.25}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := d.Add(tt.dur)
+			if got.JD1 != tt.want.JD1 || !equalJulian(Date(got.JD2), Date(tt.want.JD2)) {
+				t.Errorf("Date2.Add(%v) = %v, want %v", tt.dur, got, tt.want)
+			}
+			if back := got.Sub(d); back != tt.dur {
+				t.Errorf("Date2.Sub() = %v, want %v", back, tt.dur)
+			}
+		})
+	}
+}
+
+func TestJulianDate_Date2(t *testing.T) {
+	jd := Date(2_455_241.7229166)
+	d := jd.Date2()
+	if d.JD1 != 2_455_241 {
+		t.Errorf("JulianDate.Date2().JD1 = %v, want 2455241", d.JD1)
+	}
+	if got := d.JD(); got != jd {
+		t.Errorf("Date2.JD() = %v, want %v", got, jd)
+	}
+}
@@ -0,0 +1,71 @@
+package julian
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	rd_offset   = 1721424.5 // R.D. 0 is 12/31/0000 00:00 UTC
+	lilian_unix = 141_428   // Lilian day number of 1/1/1970, day 1 is 10/15/1582
+	ansi_unix   = 134_775   // ANSI day number of 1/1/1970, day 1 is 1/1/1601
+)
+
+// RataDie returns the Rata Die of jd, the fixed day count of Dershowitz and
+// Reingold's Calendrical Calculations, in which day 1 is January 1, 1 in the
+// proleptic Gregorian calendar. The fraction counts from midnight UTC.
+func (jd Date) RataDie() float64 {
+	return float64(jd - rd_offset)
+}
+
+// FromRataDie returns the julian date of the Rata Die rd.
+func FromRataDie(rd float64) Date {
+	return Date(rd) + rd_offset
+}
+
+// Lilian returns the Lilian day number of the UTC calendar day containing
+// jd, counting October 15, 1582, the first day of the Gregorian calendar, as
+// day 1. It is the day count of the IBM Language Environment date services.
+func (jd Date) Lilian() int64 {
+	days, _ := jd.civil()
+	return days + lilian_unix
+}
+
+// FromLilian returns the julian date of midnight UTC at the start of the day
+// with Lilian day number n.
+func FromLilian(n int64) Date {
+	return fromCivil(n-lilian_unix, 0)
+}
+
+// ANSIDate returns the ANSI day number of the UTC calendar day containing jd,
+// counting January 1, 1601 as day 1, as the COBOL INTEGER-OF-DATE function
+// does.
+func (jd Date) ANSIDate() int64 {
+	days, _ := jd.civil()
+	return days + ansi_unix
+}
+
+// FromANSIDate returns the julian date of midnight UTC at the start of the
+// day with ANSI day number n.
+func FromANSIDate(n int64) Date {
+	return fromCivil(n-ansi_unix, 0)
+}
+
+// YYYYDDD returns the ordinal date of the UTC calendar day containing jd in
+// the packed form year*1000 + day of year used by COBOL and mainframe
+// records, e.g. 2024366 for December 31, 2024.
+func (jd Date) YYYYDDD() int64 {
+	year, doy := jd.Ordinal()
+	return int64(year)*1000 + int64(doy)
+}
+
+// FromYYYYDDD returns the julian date of midnight UTC at the start of the
+// ordinal date packed as year*1000 + day of year. It returns ErrSyntax if v is
+// negative or the day is not in the year.
+func FromYYYYDDD(v int64) (Date, error) {
+	year, doy := int(v/1000), int(v%1000)
+	if v < 0 || doy < 1 || doy > 366 || doy == 366 && !isLeap(year, ProlepticGregorian) {
+		return 0, fmt.Errorf("%w: yyyyddd %d", ErrSyntax, v)
+	}
+	return fromCivil(daysFromCivil(year, time.January, 1)+int64(doy-1), 0), nil
+}
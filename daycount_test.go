@@ -0,0 +1,115 @@
+package julian
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRataDie(t *testing.T) {
+	tests := []struct {
+		name string
+		jd   Date
+		rd   float64
+	}{
+		{"R.D. 1", Time(time.Date(1, time.January, 1, 0, 0, 0, 0, time.UTC)), 1},
+		{"J2000", Date(2_451_545), 730_120.5},
+		{"1945", Time(time.Date(1945, time.November, 12, 0, 0, 0, 0, time.UTC)), 710_347},
+		{"JD 0", 0, -1_721_424.5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.jd.RataDie(); got != tt.rd {
+				t.Errorf("JulianDate.RataDie() = %v, want %v", got, tt.rd)
+			}
+			if got := FromRataDie(tt.rd); got != tt.jd {
+				t.Errorf("FromRataDie(%v) = %v, want %v", tt.rd, got, tt.jd)
+			}
+		})
+	}
+}
+
+func TestLilian(t *testing.T) {
+	tests := []struct {
+		name string
+		jd   Date
+		n    int64
+	}{
+		{"day 1", Time(time.Date(1582, time.October, 15, 0, 0, 0, 0, time.UTC)), 1},
+		{"day 0", Time(time.Date(1582, time.October, 14, 0, 0, 0, 0, time.UTC)), 0},
+		{"1970", julian_unix, 141_428},
+		{"J2000", Date(2_451_545), 152_385},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := (tt.jd + 0.25).Lilian(); got != tt.n {
+				t.Errorf("JulianDate.Lilian() = %v, want %v", got, tt.n)
+			}
+			want := Time(tt.jd.UTC().Truncate(24 * time.Hour))
+			if got := FromLilian(tt.n); got != want {
+				t.Errorf("FromLilian(%v) = %v, want %v", tt.n, got, want)
+			}
+		})
+	}
+}
+
+func TestANSIDate(t *testing.T) {
+	tests := []struct {
+		name string
+		jd   Date
+		n    int64
+	}{
+		{"day 1", Time(time.Date(1601, time.January, 1, 0, 0, 0, 0, time.UTC)), 1},
+		{"1970", julian_unix, 134_775},
+		{"J2000", Date(2_451_545), 145_732},
+		{"9999", Time(time.Date(9999, time.December, 31, 0, 0, 0, 0, time.UTC)), 3_067_671},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := (tt.jd + 0.25).ANSIDate(); got != tt.n {
+				t.Errorf("JulianDate.ANSIDate() = %v, want %v", got, tt.n)
+			}
+			want := Time(tt.jd.UTC().Truncate(24 * time.Hour))
+			if got := FromANSIDate(tt.n); got != want {
+				t.Errorf("FromANSIDate(%v) = %v, want %v", tt.n, got, want)
+			}
+		})
+	}
+}
+
+func TestYYYYDDD(t *testing.T) {
+	tests := []struct {
+		name    string
+		v       int64
+		want    Date
+		wantErr bool
+	}{
+		{"J2000", 2000001, Date(2_451_544.5), false},
+		{"leap", 2024366, Time(time.Date(2024, time.December, 31, 0, 0, 0, 0, time.UTC)), false},
+		{"year 0", 60, Time(time.Date(0, time.February, 29, 0, 0, 0, 0, time.UTC)), false},
+		{"not leap", 2023366, 0, true},
+		{"leap overflow", 2024367, 0, true},
+		{"day 0", 2023000, 0, true},
+		{"negative", -1, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FromYYYYDDD(tt.v)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("FromYYYYDDD(%v) error = %v, wantErr %v", tt.v, err, tt.wantErr)
+			}
+			if err != nil {
+				if !errors.Is(err, ErrSyntax) {
+					t.Errorf("FromYYYYDDD(%v) error = %v, want ErrSyntax", tt.v, err)
+				}
+				return
+			}
+			if got != tt.want {
+				t.Errorf("FromYYYYDDD(%v) = %v, want %v", tt.v, got, tt.want)
+			}
+			if back := (got + 0.75).YYYYDDD(); back != tt.v {
+				t.Errorf("JulianDate.YYYYDDD() = %v, want %v", back, tt.v)
+			}
+		})
+	}
+}
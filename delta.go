@@ -0,0 +1,55 @@
+package julian
+
+import (
+	"strconv"
+	"time"
+)
+
+// A Delta is the difference between two julian dates in days, as returned by
+// Diff and accepted by AddDelta. As a distinct type, a Delta cannot be passed
+// where a Date is expected, or a Date where a Delta is, without a conversion.
+//
+// Delta does not stop two dates being added to each other: Date is a float64
+// type, so jd1+jd2 compiles, and making it otherwise would break every use of
+// Date as a number. Sub and the + and - operators on Date are unchanged; use
+// Diff and AddDelta where the type check is wanted.
+type Delta float64
+
+// Diff returns the difference jd-other as a Delta.
+func (jd Date) Diff(other Date) Delta {
+	return Delta(jd - other)
+}
+
+// AddDelta returns the julian date jd+d.
+func (jd Date) AddDelta(d Delta) Date {
+	return jd + Date(d)
+}
+
+// Days returns the delta as a number of days.
+func (d Delta) Days() float64 {
+	return float64(d)
+}
+
+// Duration returns the delta as a time.Duration, saturating at the minimum or
+// maximum duration as Days does.
+func (d Delta) Duration() time.Duration {
+	return Days(float64(d))
+}
+
+// Abs returns the absolute value of d.
+func (d Delta) Abs() Delta {
+	if d < 0 {
+		return -d
+	}
+	return d
+}
+
+// DeltaOf returns the delta of the given duration.
+func DeltaOf(d time.Duration) Delta {
+	return Delta(float64(d) / day_nanoseconds)
+}
+
+// String returns the delta formatted as a number of days, e.g. "1.5d".
+func (d Delta) String() string {
+	return strconv.FormatFloat(float64(d), 'f', -1, 64) + "d"
+}
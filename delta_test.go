@@ -0,0 +1,63 @@
+package julian
+
+import (
+	"testing"
+	"time"
+)
+
+func TestJulianDate_Diff(t *testing.T) {
+	tests := []struct {
+		name string
+		jd   Date
+		from Date
+		want Delta
+	}{
+		{"day", Date(2_451_546.0), Date(2_451_545.0), 1},
+		{"negative", Date(2_451_545.0), Date(2_451_546.5), -1.5},
+		{"same", Date(2_451_545.0), Date(2_451_545.0), 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.jd.Diff(tt.from)
+			if got != tt.want {
+				t.Errorf("JulianDate.Diff() = %v, want %v", got, tt.want)
+			}
+			if back := tt.from.AddDelta(got); back != tt.jd {
+				t.Errorf("JulianDate.AddDelta(%v) = %v, want %v", got, back, tt.jd)
+			}
+		})
+	}
+}
+
+func TestDelta(t *testing.T) {
+	tests := []struct {
+		name     string
+		d        Delta
+		duration time.Duration
+		abs      Delta
+		str      string
+	}{
+		{"zero", 0, 0, 0, "0d"},
+		{"day and a half", 1.5, 36 * time.Hour, 1.5, "1.5d"},
+		{"negative", -0.25, -6 * time.Hour, 0.25, "-0.25d"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.d.Duration(); got != tt.duration {
+				t.Errorf("Delta.Duration() = %v, want %v", got, tt.duration)
+			}
+			if got := DeltaOf(tt.duration); got != tt.d {
+				t.Errorf("DeltaOf(%v) = %v, want %v", tt.duration, got, tt.d)
+			}
+			if got := tt.d.Abs(); got != tt.abs {
+				t.Errorf("Delta.Abs() = %v, want %v", got, tt.abs)
+			}
+			if got := tt.d.String(); got != tt.str {
+				t.Errorf("Delta.String() = %q, want %q", got, tt.str)
+			}
+			if got := tt.d.Days(); got != float64(tt.d) {
+				t.Errorf("Delta.Days() = %v, want %v", got, float64(tt.d))
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package julian
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestDeltaT(t *testing.T) {
+	year := func(y int) Date { return NewDate(y, time.January, 1, 0, 0, 0, 0, time.UTC) }
+	tests := []struct {
+		name string
+		jd   Date
+		want float64
+		tol  float64
+	}{
+		{"-1000", year(-1000), 25_428, 5},
+		{"0", year(0), 10_583.6, 1},
+		{"1000", year(1000), 1_574.2, 1},
+		{"1600", year(1600), 120, 0.1},
+		{"1800", year(1800), 13.72, 0.1},
+		{"1900", year(1900), -2.79, 0.1},
+		{"1950", year(1950), 29.07, 0.1},
+		{"1975", year(1975), 45.45, 0.1},
+		{"2000", year(2000), 63.86, 0.1},
+		{"2020", year(2020), 71.62, 0.1},
+		{"2100", year(2100), 202.8, 0.5},
+		{"3000", year(3000), 4_435.7, 0.5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DeltaT(tt.jd); math.Abs(got-tt.want) > tt.tol {
+				t.Errorf("DeltaT() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDeltaT_continuous(t *testing.T) {
+	for _, y := range []int{-500, 500, 1600, 1700, 1800, 1860, 1900, 1920, 1941, 1961, 1986, 2005, 2050, 2150} {
+		jd := NewDate(y, time.January, 1, 0, 0, 0, 0, time.UTC)
+		if d := math.Abs(DeltaT(jd-1) - DeltaT(jd)); d > 5 {
+			t.Errorf("DeltaT() jumps %vs at %d", d, y)
+		}
+	}
+}
@@ -0,0 +1,149 @@
+package julian
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// A DUT1Provider supplies UT1-UTC, the difference between the time kept by
+// the Earth's rotation and UTC, in seconds at a UTC julian date.
+type DUT1Provider interface {
+	DUT1(utc Date) (float64, error)
+}
+
+// ConstantDUT1 is a DUT1Provider returning a fixed UT1-UTC in seconds, such
+// as the DUT1 broadcast with time signals.
+type ConstantDUT1 float64
+
+// DUT1 implements the DUT1Provider interface.
+func (c ConstantDUT1) DUT1(Date) (float64, error) {
+	return float64(c), nil
+}
+
+// UTCToUT1 converts a UTC julian date to UT1 using UT1-UTC from p.
+func UTCToUT1(utc Date, p DUT1Provider) (Date, error) {
+	dut1, err := p.DUT1(utc)
+	if err != nil {
+		return 0, err
+	}
+	return ApplyDUT1(utc, dut1), nil
+}
+
+// UT1ToUTC converts a UT1 julian date to UTC using UT1-UTC from p. It is the
+// inverse of UTCToUT1.
+func UT1ToUTC(ut1 Date, p DUT1Provider) (Date, error) {
+	utc := ut1
+	for range 2 {
+		dut1, err := p.DUT1(utc)
+		if err != nil {
+			return 0, err
+		}
+		utc = RemoveDUT1(ut1, dut1)
+	}
+	return utc, nil
+}
+
+// An EOPEntry is a daily value of UT1-UTC from the IERS Earth orientation
+// parameters, at 0h UTC on the day MJD.
+type EOPEntry struct {
+	MJD  float64
+	DUT1 float64 // UT1-UTC in seconds
+}
+
+// An EOPTable is a DUT1Provider that interpolates linearly between daily
+// Earth orientation values in increasing order of MJD.
+type EOPTable struct {
+	Entries []EOPEntry
+}
+
+// DUT1 implements the DUT1Provider interface. It returns ErrRange for a
+// date outside the table. Interpolation across a leap second allows for the
+// one second step in UT1-UTC.
+func (t *EOPTable) DUT1(utc Date) (float64, error) {
+	mjd := float64(utc - mjd_offset)
+	e := t.Entries
+	i := sort.Search(len(e), func(i int) bool { return e[i].MJD > mjd })
+	switch {
+	case i == 0:
+		return 0, fmt.Errorf("%w: MJD %v before the EOP table", ErrRange, mjd)
+	case i == len(e):
+		if mjd == e[i-1].MJD {
+			return e[i-1].DUT1, nil
+		}
+		return 0, fmt.Errorf("%w: MJD %v after the EOP table", ErrRange, mjd)
+	}
+	a, b := e[i-1], e[i]
+	d := b.DUT1 - a.DUT1
+	if d > 0.5 {
+		d-- // a leap second was inserted at the end of a's day
+	} else if d < -0.5 {
+		d++
+	}
+	return a.DUT1 + d*(mjd-a.MJD)/(b.MJD-a.MJD), nil
+}
+
+// ParseFinals2000A parses the IERS finals2000A.all, finals2000A.daily or
+// finals2000A.data files, taking the MJD from columns 8-15 and the Bulletin A
+// UT1-UTC from columns 59-68. Lines without a UT1-UTC value, past the end of
+// the predictions, are skipped.
+func ParseFinals2000A(r io.Reader) (*EOPTable, error) {
+	t := &EOPTable{}
+	sc := bufio.NewScanner(r)
+	for n := 1; sc.Scan(); n++ {
+		line := sc.Text()
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		if len(line) < 68 {
+			if len(line) >= 15 {
+				continue // no UT1-UTC value
+			}
+			return nil, fmt.Errorf("%w: line %d: %q", ErrSyntax, n, line)
+		}
+		v := strings.TrimSpace(line[58:68])
+		if v == "" {
+			continue
+		}
+		mjd, err1 := strconv.ParseFloat(strings.TrimSpace(line[7:15]), 64)
+		dut1, err2 := strconv.ParseFloat(v, 64)
+		if err1 != nil || err2 != nil {
+			return nil, fmt.Errorf("%w: line %d: %q", ErrSyntax, n, line)
+		}
+		t.Entries = append(t.Entries, EOPEntry{mjd, dut1})
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// ParseEOPC04 parses the IERS EOP 14 C04 series, whose data lines give the
+// year, month, day, MJD, the pole coordinates x and y, and UT1-UTC. Header
+// lines are skipped.
+func ParseEOPC04(r io.Reader) (*EOPTable, error) {
+	t := &EOPTable{}
+	sc := bufio.NewScanner(r)
+	for n := 1; sc.Scan(); n++ {
+		f := strings.Fields(sc.Text())
+		if len(f) < 7 {
+			continue
+		}
+		if _, err := strconv.Atoi(f[0]); err != nil {
+			continue // header
+		}
+		mjd, err1 := strconv.ParseFloat(f[3], 64)
+		dut1, err2 := strconv.ParseFloat(f[6], 64)
+		if err1 != nil || err2 != nil {
+			return nil, fmt.Errorf("%w: line %d: %q", ErrSyntax, n, sc.Text())
+		}
+		t.Entries = append(t.Entries, EOPEntry{mjd, dut1})
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
@@ -0,0 +1,119 @@
+package julian
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+)
+
+// finalsLine returns a finals2000A line with the MJD and, if not empty, the
+// UT1-UTC value in their columns.
+func finalsLine(mjd float64, dut1 string) string {
+	b := []byte(strings.Repeat(" ", 80))
+	copy(b[0:6], "170101")
+	copy(b[7:15], fmt.Sprintf("%8.2f", mjd))
+	if dut1 != "" {
+		b[57] = 'I'
+		copy(b[58:68], fmt.Sprintf("%10s", dut1))
+	}
+	return string(b)
+}
+
+func TestParseFinals2000A(t *testing.T) {
+	in := strings.Join([]string{
+		finalsLine(57752, "-0.4083"),
+		finalsLine(57753, "-0.4093"),
+		finalsLine(57754, " 0.5912"), // after the 2017 leap second
+		finalsLine(57755, " 0.5902"),
+		finalsLine(60000, ""),
+	}, "\n")
+	table, err := ParseFinals2000A(strings.NewReader(in))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(table.Entries) != 4 {
+		t.Fatalf("ParseFinals2000A() = %d entries, want 4", len(table.Entries))
+	}
+	if got := table.Entries[2]; got != (EOPEntry{57754, 0.5912}) {
+		t.Errorf("ParseFinals2000A() entry = %+v", got)
+	}
+
+	tests := []struct {
+		name    string
+		mjd     float64
+		want    float64
+		wantErr bool
+	}{
+		{"entry", 57752, -0.4083, false},
+		{"midday", 57752.5, -0.4088, false},
+		{"across leap second", 57753.5, -0.4093 + 0.00025, false},
+		{"last", 57755, 0.5902, false},
+		{"before", 57751, 0, true},
+		{"after", 57756, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := table.DUT1(Date(tt.mjd + mjd_offset))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("EOPTable.DUT1() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrRange) {
+				t.Errorf("EOPTable.DUT1() error = %v, want ErrRange", err)
+			}
+			if math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("EOPTable.DUT1() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseFinals2000A_errors(t *testing.T) {
+	if _, err := ParseFinals2000A(strings.NewReader("170101")); !errors.Is(err, ErrSyntax) {
+		t.Errorf("ParseFinals2000A() short line error = %v, want ErrSyntax", err)
+	}
+	if _, err := ParseFinals2000A(strings.NewReader(finalsLine(57752, "x"))); !errors.Is(err, ErrSyntax) {
+		t.Errorf("ParseFinals2000A() bad value error = %v, want ErrSyntax", err)
+	}
+}
+
+func TestParseEOPC04(t *testing.T) {
+	in := `                          EARTH ORIENTATION PARAMETER (EOP) PRODUCT CENTER CENTER (PARIS OBSERVATORY)
+ YR  MM  DD  HH       MJD        x(")        y(")  UT1-UTC(s)       dX(")      dY(")
+2000   1   1  51544   0.043282   0.377909   0.3554012   0.0009554  -0.000068   0.000184
+2000   1   2  51545   0.043045   0.378603   0.3546008   0.0007588  -0.000025   0.000166
+`
+	table, err := ParseEOPC04(strings.NewReader(in))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []EOPEntry{{51544, 0.3554012}, {51545, 0.3546008}}
+	if len(table.Entries) != 2 || table.Entries[0] != want[0] || table.Entries[1] != want[1] {
+		t.Errorf("ParseEOPC04() = %v, want %v", table.Entries, want)
+	}
+}
+
+func TestUTCToUT1(t *testing.T) {
+	table := &EOPTable{Entries: []EOPEntry{{51544, 0.3554012}, {51545, 0.3546008}}}
+	utc := Date(51544.5 + mjd_offset)
+	for _, p := range []DUT1Provider{table, ConstantDUT1(0.355)} {
+		ut1, err := UTCToUT1(utc, p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !equalSeconds(ut1, utc+0.355/day_seconds, 1e-3) {
+			t.Errorf("UTCToUT1(%T) = %v, want UTC+0.355s", p, ut1)
+		}
+		back, err := UT1ToUTC(ut1, p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !equalSeconds(back, utc, 1e-5) {
+			t.Errorf("UT1ToUTC(UTCToUT1()) = %v, want %v", back, utc)
+		}
+	}
+	if _, err := UTCToUT1(utc+10, table); !errors.Is(err, ErrRange) {
+		t.Errorf("UTCToUT1() outside table error = %v, want ErrRange", err)
+	}
+}
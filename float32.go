@@ -0,0 +1,25 @@
+package julian
+
+import (
+	"math"
+	"time"
+)
+
+// Float32 returns jd rounded to the nearest float32. A float32 resolves a
+// modern julian date only to a quarter of a day; use Float32Loss to measure
+// the error for a particular date.
+func (jd Date) Float32() float32 {
+	return float32(jd)
+}
+
+// FromFloat32 returns the julian date of the float32 value f.
+func FromFloat32(f float32) Date {
+	return Date(f)
+}
+
+// Float32Loss returns the magnitude of the error introduced by storing jd as
+// a float32, that is, the absolute difference between jd and
+// FromFloat32(jd.Float32()).
+func (jd Date) Float32Loss() time.Duration {
+	return Days(math.Abs(float64(jd - FromFloat32(jd.Float32()))))
+}
@@ -0,0 +1,34 @@
+package julian
+
+import (
+	"testing"
+	"time"
+)
+
+func TestJulianDate_Float32(t *testing.T) {
+	tests := []struct {
+		name string
+		jd   Date
+		want float32
+		loss time.Duration
+	}{
+		{"exact", Date(2_451_545.25), 2_451_545.25, 0},
+		{"rounded", Date(2_451_545.1), 2_451_545, 2*time.Hour + 24*time.Minute},
+		{"small", Date(0.5), 0.5, 0},
+		{"mjd", Date(51_544.5), 51_544.5, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.jd.Float32()
+			if got != tt.want {
+				t.Errorf("JulianDate.Float32() = %v, want %v", got, tt.want)
+			}
+			if back := FromFloat32(got); back != Date(tt.want) {
+				t.Errorf("FromFloat32(%v) = %v, want %v", got, back, tt.want)
+			}
+			if loss := tt.jd.Float32Loss(); (loss - tt.loss).Abs() > time.Millisecond {
+				t.Errorf("JulianDate.Float32Loss() = %v, want %v", loss, tt.loss)
+			}
+		})
+	}
+}
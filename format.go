@@ -0,0 +1,232 @@
+package julian
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"sync/atomic"
+)
+
+const (
+	mjd_offset     = 2400000.5 // MJD 0 is 11/17/1858 00:00 UTC
+	default_digits = 5
+)
+
+// ErrSyntax indicates that a value does not have the right syntax for a
+// julian date.
+var ErrSyntax = errors.New("julian: invalid syntax")
+
+// precision is the number of fractional digits used by String and the
+// marshaling methods; a negative value selects the shortest exact form.
+var precision atomic.Int32
+
+func init() {
+	precision.Store(-1)
+}
+
+// SetPrecision sets the number of fractional digits that String,
+// MarshalText and MarshalJSON emit, for consumers that cannot parse the long
+// decimal forms of a float64. A negative digits restores the default, the
+// smallest number of digits that represents the value exactly, which parses
+// back to the same Date. SetPrecision is safe for concurrent use.
+func SetPrecision(digits int) {
+	precision.Store(int32(max(digits, -1)))
+}
+
+// Precision returns the number of fractional digits set by SetPrecision, or
+// -1 for the shortest exact form.
+func Precision() int {
+	return int(precision.Load())
+}
+
+// String returns the julian date formatted as "JD 2451545.5", with the number
+// of fractional digits set by SetPrecision.
+func (jd Date) String() string {
+	return jd.StringPrec(Precision())
+}
+
+// MarshalText implements the encoding.TextMarshaler interface. The date is
+// formatted as a plain number with the digits set by SetPrecision.
+func (jd Date) MarshalText() ([]byte, error) {
+	return strconv.AppendFloat(nil, float64(jd), 'f', Precision(), 64), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface. The date
+// may be in any form accepted by Parse.
+func (jd *Date) UnmarshalText(data []byte) error {
+	v, err := Parse(string(data))
+	if err != nil {
+		return err
+	}
+	*jd = v
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface. The date is encoded as
+// a JSON number with the digits set by SetPrecision. It returns an error if
+// the date is NaN or an infinity, which JSON cannot represent.
+func (jd Date) MarshalJSON() ([]byte, error) {
+	if !jd.IsValid() {
+		return nil, fmt.Errorf("julian: cannot marshal %v as JSON", float64(jd))
+	}
+	return jd.MarshalText()
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It accepts a JSON
+// number or a JSON string in any form accepted by Parse. As with the
+// encoding/json package, null is a no-op.
+func (jd *Date) UnmarshalJSON(data []byte) error {
+	s := string(bytes.TrimSpace(data))
+	if s == "null" {
+		return nil
+	}
+	if strings.HasPrefix(s, `"`) {
+		var err error
+		if s, err = strconv.Unquote(s); err != nil {
+			return fmt.Errorf("%w: %s", ErrSyntax, data)
+		}
+	}
+	return jd.UnmarshalText([]byte(s))
+}
+
+// StringPrec returns the julian date formatted like String with the given
+// number of fractional digits. A negative digits uses the smallest number of
+// digits necessary to represent the value exactly.
+func (jd Date) StringPrec(digits int) string {
+	b := make([]byte, 0, 24)
+	b = append(b, "JD "...)
+	b = strconv.AppendFloat(b, float64(jd), 'f', digits, 64)
+	return string(b)
+}
+
+// Format implements fmt.Formatter.
+//
+// The %v and %s verbs print the value as String does, using the precision,
+// if any, in place of the digits set by SetPrecision. The floating-point verbs %e,
+// %f, %g and friends format the julian date as a float64, %d formats the
+// julian day number as returned by JDN, and %m formats the modified julian
+// date in the style of %f. Unlike the {day} token of FormatLayout, %d is not
+// floor(jd).
+func (jd Date) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 'v', 's':
+		if verb == 'v' && f.Flag('#') {
+			fmt.Fprintf(f, "julian.Date(%s)", strconv.FormatFloat(float64(jd), 'f', -1, 64))
+			return
+		}
+		s := jd.String()
+		if p, ok := f.Precision(); ok {
+			s = jd.StringPrec(p)
+		}
+		pad(f, s)
+	case 'e', 'E', 'f', 'F', 'g', 'G':
+		fmt.Fprintf(f, fmt.FormatString(f, verb), float64(jd))
+	case 'd':
+		fmt.Fprintf(f, fmt.FormatString(f, verb), jd.JDN())
+	case 'm':
+		fmt.Fprintf(f, fmt.FormatString(f, 'f'), float64(jd-mjd_offset))
+	default:
+		fmt.Fprintf(f, "%%!%c(julian.Date=%s)", verb, jd.String())
+	}
+}
+
+// pad writes s to f padded with spaces to the width of f.
+func pad(f fmt.State, s string) {
+	w, ok := f.Width()
+	if !ok || len(s) >= w {
+		io.WriteString(f, s)
+		return
+	}
+	padding := strings.Repeat(" ", w-len(s))
+	if f.Flag('-') {
+		io.WriteString(f, s+padding)
+	} else {
+		io.WriteString(f, padding+s)
+	}
+}
+
+// A ClockOrigin selects the instant from which ClockStringPrec measures the
+// time of day.
+type ClockOrigin int
+
+const (
+	FromMidnight ClockOrigin = iota // civil time of day, since midnight UTC
+	FromNoon                        // time since the noon that starts the julian day
+)
+
+// ClockString returns the time of day of jd since midnight UTC formatted as
+// "15:04:05.000".
+func (jd Date) ClockString() string {
+	return jd.ClockStringPrec(3, FromMidnight)
+}
+
+// ClockStringPrec returns the time of day of jd since the given origin
+// formatted as "15:04:05" followed by digits fractional second digits. The
+// digits are clamped to [0, 9] and the value is rounded to the last digit.
+func (jd Date) ClockStringPrec(digits int, origin ClockOrigin) string {
+	digits = min(max(digits, 0), 9)
+	_, nsec := jd.civil()
+	if origin == FromNoon {
+		nsec = (nsec + day_nanoseconds/2) % day_nanoseconds
+	}
+	unit := int64(math.Pow10(9 - digits))
+	nsec = (nsec + unit/2) / unit * unit % day_nanoseconds
+	sec := nsec / 1e9
+	b := make([]byte, 0, 18)
+	b = appendInt2(b, sec/3600)
+	b = append(b, ':')
+	b = appendInt2(b, sec/60%60)
+	b = append(b, ':')
+	b = appendInt2(b, sec%60)
+	if digits > 0 {
+		b = append(b, '.')
+		frac := strconv.AppendInt(nil, nsec%1e9+1e9, 10)
+		b = append(b, frac[1:1+digits]...)
+	}
+	return string(b)
+}
+
+// appendInt2 appends the two digit decimal form of n, which must be in
+// [0, 99].
+func appendInt2(b []byte, n int64) []byte {
+	return append(b, byte('0'+n/10), byte('0'+n%10))
+}
+
+// Parse parses a textual julian date.
+//
+// The value is either a plain number, a julian date prefixed with "JD", or a
+// modified julian date prefixed with "MJD". The prefix is case insensitive and
+// may be separated from the number by spaces, so "2451545.0", "JD 2451545.0",
+// "JD2455241.72" and "MJD 51544.5" are all accepted. A value that is not a
+// number is parsed as an ISO 8601 date and time by ParseISO, so
+// "2000-01-01T12:00:00Z" and "-0044-03-15" are accepted too.
+func Parse(s string) (Date, error) {
+	v := strings.TrimSpace(s)
+	offset := 0.0
+	switch {
+	case hasPrefixFold(v, "MJD"):
+		v = v[3:]
+		offset = mjd_offset
+	case hasPrefixFold(v, "JD"):
+		v = v[2:]
+	}
+	v = strings.TrimLeft(v, " ")
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil && offset == 0 && v == strings.TrimSpace(s) {
+		if jd, err := ParseISO(v); err == nil {
+			return jd, nil
+		}
+	}
+	if err != nil || math.IsNaN(f) || math.IsInf(f, 0) {
+		return 0, fmt.Errorf("%w: %q", ErrSyntax, s)
+	}
+	return Date(f + offset), nil
+}
+
+func hasPrefixFold(s, prefix string) bool {
+	return len(s) >= len(prefix) && strings.EqualFold(s[:len(prefix)], prefix)
+}
@@ -0,0 +1,263 @@
+package julian
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    Date
+		wantErr bool
+	}{
+		{"plain", "2451545.0", Date(2_451_545.0), false},
+		{"JD", "JD 2451545.0", Date(2_451_545.0), false},
+		{"JD no space", "JD2455241.72", Date(2_455_241.72), false},
+		{"MJD", "MJD 51544.5", Date(2_451_545.0), false},
+		{"lower case", "jd 2451545.25", Date(2_451_545.25), false},
+		{"surrounding space", "  2451545.5 ", Date(2_451_545.5), false},
+		{"empty", "", 0, true},
+		{"prefix only", "JD", 0, true},
+		{"garbage", "JD 24515x", 0, true},
+		{"NaN", "NaN", 0, true},
+		{"Inf", "JD +Inf", 0, true},
+		{"ISO", "2000-01-01T12:00:00Z", Date(2_451_545.0), false},
+		{"expanded ISO", " -0044-03-15 ", Date(1_705_062.5), false},
+		{"prefixed ISO", "JD 2000-01-01", 0, true},
+		{"bad ISO", "2000-02-30", 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.s)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Parse(%q) error = %v, wantErr %v", tt.s, err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrSyntax) {
+				t.Errorf("Parse(%q) error = %v, want ErrSyntax", tt.s, err)
+			}
+			if !equalJulian(got, tt.want) {
+				t.Errorf("Parse(%q) = %f, want %f", tt.s, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJulianDate_String(t *testing.T) {
+	tests := []struct {
+		name string
+		jd   Date
+		want string
+	}{
+		{"J2000", Date(2_451_545.0), "JD 2451545"},
+		{"half", Date(2_451_545.5), "JD 2451545.5"},
+		{"exact", Date(2_455_241.7229166), "JD 2455241.7229166"},
+		{"zero", Date(0), "JD 0"},
+		{"negative", Date(-1.25), "JD -1.25"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.jd.String(); got != tt.want {
+				t.Errorf("JulianDate.String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSetPrecision(t *testing.T) {
+	defer SetPrecision(-1)
+	jd := Date(2_455_241.7229166)
+	tests := []struct {
+		digits    int
+		precision int
+		str, text string
+	}{
+		{-1, -1, "JD 2455241.7229166", "2455241.7229166"},
+		{3, 3, "JD 2455241.723", "2455241.723"},
+		{0, 0, "JD 2455242", "2455242"},
+		{-5, -1, "JD 2455241.7229166", "2455241.7229166"},
+	}
+	for _, tt := range tests {
+		SetPrecision(tt.digits)
+		if got := Precision(); got != tt.precision {
+			t.Errorf("SetPrecision(%d); Precision() = %d, want %d", tt.digits, got, tt.precision)
+		}
+		if got := jd.String(); got != tt.str {
+			t.Errorf("SetPrecision(%d); String() = %q, want %q", tt.digits, got, tt.str)
+		}
+		if got, _ := jd.MarshalText(); string(got) != tt.text {
+			t.Errorf("SetPrecision(%d); MarshalText() = %q, want %q", tt.digits, got, tt.text)
+		}
+		if got, _ := jd.MarshalJSON(); string(got) != tt.text {
+			t.Errorf("SetPrecision(%d); MarshalJSON() = %s, want %s", tt.digits, got, tt.text)
+		}
+	}
+}
+
+func TestJulianDate_JSON(t *testing.T) {
+	type record struct {
+		Epoch Date `json:"epoch"`
+	}
+	jd := Date(2_455_241.72291667)
+	b, err := json.Marshal(record{jd})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"epoch":2455241.72291667}`; string(b) != want {
+		t.Errorf("json.Marshal() = %s, want %s", b, want)
+	}
+	var got record
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Epoch != jd {
+		t.Errorf("json.Unmarshal(%s) = %v, want %v", b, got.Epoch, jd)
+	}
+	if _, err := json.Marshal(record{Date(math.NaN())}); err == nil {
+		t.Errorf("json.Marshal(NaN) succeeded, want error")
+	}
+}
+
+func TestJulianDate_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		data    string
+		want    Date
+		wantErr bool
+	}{
+		{`2451545.5`, 2_451_545.5, false},
+		{`"MJD 51544.5"`, 2_451_545, false},
+		{`null`, 1, false},
+		{`"x"`, 1, true},
+		{`"unterminated`, 1, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.data, func(t *testing.T) {
+			got := Date(1)
+			err := got.UnmarshalJSON([]byte(tt.data))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("JulianDate.UnmarshalJSON(%s) error = %v, wantErr %v", tt.data, err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrSyntax) {
+				t.Errorf("JulianDate.UnmarshalJSON(%s) error = %v, want ErrSyntax", tt.data, err)
+			}
+			if got != tt.want {
+				t.Errorf("JulianDate.UnmarshalJSON(%s) = %v, want %v", tt.data, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJulianDate_StringPrec(t *testing.T) {
+	tests := []struct {
+		name   string
+		jd     Date
+		digits int
+		want   string
+	}{
+		{"none", Date(2_451_545.5), 0, "JD 2451546"},
+		{"two", Date(2_451_545.5), 2, "JD 2451545.50"},
+		{"eight", Date(2_455_241.72291667), 8, "JD 2455241.72291667"},
+		{"shortest", Date(2_451_545.25), -1, "JD 2451545.25"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.jd.StringPrec(tt.digits); got != tt.want {
+				t.Errorf("JulianDate.StringPrec(%d) = %q, want %q", tt.digits, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParse_String(t *testing.T) {
+	jd := Date(2_455_241.72291667)
+	got, err := Parse(jd.StringPrec(-1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != jd {
+		t.Errorf("Parse(String()) = %f, want %f", got, jd)
+	}
+}
+
+func TestJulianDate_Format(t *testing.T) {
+	jd := Date(2_455_241.7229166)
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{"%v", "JD 2455241.7229166"},
+		{"%s", "JD 2455241.7229166"},
+		{"%.2v", "JD 2455241.72"},
+		{"%20v", "  JD 2455241.7229166"},
+		{"%-20v|", "JD 2455241.7229166  |"},
+		{"%#v", "julian.Date(2455241.7229166)"},
+		{"%f", "2455241.722917"},
+		{"%.3f", "2455241.723"},
+		{"%12.1f", "   2455241.7"},
+		{"%e", "2.455242e+06"},
+		{"%d", "2455242"},
+		{"%08d", "02455242"},
+		{"%m", "55241.222917"},
+		{"%.1m", "55241.2"},
+		{"%x", "%!x(julian.Date=JD 2455241.7229166)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			if got := fmt.Sprintf(tt.format, jd); got != tt.want {
+				t.Errorf("Sprintf(%q) = %q, want %q", tt.format, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJulianDate_ClockString(t *testing.T) {
+	tests := []struct {
+		name string
+		jd   Date
+		want string
+	}{
+		{"noon", Date(2_451_545.0), "12:00:00.000"},
+		{"midnight", Date(2_451_544.5), "00:00:00.000"},
+		{"Feb. 14, 2010 5:21", Time(time.Date(2010, time.February, 14, 5, 21, 0, 0, time.UTC)), "05:21:00.000"},
+		{"millis", Time(time.Date(2010, time.February, 14, 23, 59, 59, 250_000_000, time.UTC)), "23:59:59.250"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.jd.ClockString(); got != tt.want {
+				t.Errorf("JulianDate.ClockString() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJulianDate_ClockStringPrec(t *testing.T) {
+	jd := Time(time.Date(2010, time.February, 14, 5, 21, 7, 123_456_000, time.UTC))
+	tests := []struct {
+		name   string
+		jd     Date
+		digits int
+		origin ClockOrigin
+		want   string
+	}{
+		{"seconds", jd, 0, FromMidnight, "05:21:07"},
+		{"tenths", jd, 1, FromMidnight, "05:21:07.1"},
+		{"micros", jd, 5, FromMidnight, "05:21:07.12346"},
+		{"clamped", jd, -3, FromMidnight, "05:21:07"},
+		{"noon", jd, 3, FromNoon, "17:21:07.123"},
+		{"noon origin", Date(2_451_545.25), 0, FromNoon, "06:00:00"},
+		{"rounds up", Time(time.Date(2010, time.February, 14, 5, 21, 59, 999_900_000, time.UTC)), 2, FromMidnight, "05:22:00.00"},
+		{"wraps", Time(time.Date(2010, time.February, 14, 23, 59, 59, 999_900_000, time.UTC)), 0, FromMidnight, "00:00:00"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.jd.ClockStringPrec(tt.digits, tt.origin); got != tt.want {
+				t.Errorf("JulianDate.ClockStringPrec() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
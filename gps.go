@@ -0,0 +1,40 @@
+package julian
+
+import "math"
+
+// gps_epoch is the julian date of the GPS epoch, 0h on January 6, 1980, on
+// the GPS time scale.
+const gps_epoch = 2444244.5
+
+const week_seconds = 7 * day_seconds
+
+// ToGPS returns the UTC julian date jd as a GPS week number, counted from the
+// GPS epoch without rollover, and the seconds into that week. The leap
+// seconds between UTC and GPS time are applied.
+func ToGPS(jd Date) (week int, sow float64) {
+	days := float64(UTCToGPS(jd) - gps_epoch)
+	w := math.Floor(days / 7)
+	sow = (days - w*7) * day_seconds
+	if sow >= week_seconds {
+		w, sow = w+1, 0
+	}
+	return int(w), sow
+}
+
+// FromGPS returns the UTC julian date of the given GPS week and seconds of
+// week. The seconds may be outside [0, 604800) and are carried into the
+// week. It is the inverse of ToGPS.
+func FromGPS(week int, sow float64) Date {
+	return GPSToUTC(gps_epoch + Date(week*7) + Date(sow/day_seconds))
+}
+
+// ResolveGPSWeek returns the full GPS week number for a 10-bit week number,
+// as broadcast by receivers before the modernized navigation messages, which
+// rolls over every 1024 weeks (in 1999 and 2019). The week chosen is the one
+// nearest to the UTC julian date approx, so approx need only be correct to
+// within about 9 years.
+func ResolveGPSWeek(week10 int, approx Date) int {
+	week10 = int(floorMod(int64(week10), 1024))
+	w, _ := ToGPS(approx)
+	return week10 + 1024*int(math.Round(float64(w-week10)/1024))
+}
@@ -0,0 +1,66 @@
+package julian
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestToGPS(t *testing.T) {
+	tests := []struct {
+		name string
+		utc  time.Time
+		week int
+		sow  float64
+	}{
+		{"epoch", time.Date(1980, time.January, 6, 0, 0, 0, 0, time.UTC), 0, 0},
+		{"1999 rollover", time.Date(1999, time.August, 21, 23, 59, 47, 0, time.UTC), 1024, 0},
+		{"J2000", time.Date(2000, time.January, 1, 12, 0, 0, 0, time.UTC), 1042, 6*day_seconds + 12*3600 + 13},
+		{"2019 rollover", time.Date(2019, time.April, 6, 23, 59, 42, 0, time.UTC), 2048, 0},
+		{"2024", time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC), 2303, 5*day_seconds + 18},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jd := Time(tt.utc)
+			week, sow := ToGPS(jd)
+			if sow > week_seconds-1e-3 {
+				week, sow = week+1, sow-week_seconds
+			}
+			if week != tt.week || math.Abs(sow-tt.sow) > 1e-3 {
+				t.Errorf("ToGPS() = %d, %v, want %d, %v", week, sow, tt.week, tt.sow)
+			}
+			if got := FromGPS(tt.week, tt.sow); !equalSeconds(got, jd, 1e-4) {
+				t.Errorf("FromGPS() = %v, want %v", got.UTC(), tt.utc)
+			}
+		})
+	}
+}
+
+func TestFromGPS_carry(t *testing.T) {
+	if a, b := FromGPS(2000, week_seconds+10), FromGPS(2001, 10); !equalSeconds(a, b, 1e-6) {
+		t.Errorf("FromGPS(2000, 604810) = %v, want %v", a, b)
+	}
+}
+
+func TestResolveGPSWeek(t *testing.T) {
+	tests := []struct {
+		name   string
+		week10 int
+		approx time.Time
+		want   int
+	}{
+		{"first era", 500, time.Date(1990, time.January, 1, 0, 0, 0, 0, time.UTC), 500},
+		{"second era", 500, time.Date(2009, time.June, 1, 0, 0, 0, 0, time.UTC), 1524},
+		{"third era", 255, time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC), 2303},
+		{"after 1999 rollover", 2, time.Date(1999, time.June, 1, 0, 0, 0, 0, time.UTC), 1026},
+		{"before 2019 rollover", 1020, time.Date(2019, time.June, 1, 0, 0, 0, 0, time.UTC), 2044},
+		{"out of range", 1024 + 255, time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC), 2303},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ResolveGPSWeek(tt.week10, Time(tt.approx)); got != tt.want {
+				t.Errorf("ResolveGPSWeek(%d) = %d, want %d", tt.week10, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,199 @@
+package julian
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ISODate returns the proleptic Gregorian date of the UTC calendar day
+// containing jd in the ISO 8601 extended format, e.g. "2000-01-01". Years
+// outside [0, 9999] use the expanded form with a sign and at least four
+// digits, e.g. "-0044-03-15" or "+10000-01-01".
+func (jd Date) ISODate() string {
+	days, _ := jd.civil()
+	return string(appendISODate(nil, days))
+}
+
+// ISOString returns the UTC date and time of jd in the ISO 8601 extended
+// format with milliseconds, e.g. "2000-01-01T12:00:00.000Z", with expanded
+// years as for ISODate.
+func (jd Date) ISOString() string {
+	days, nsec := jd.civil()
+	nsec = (nsec + 5e5) / 1e6 * 1e6
+	if nsec >= day_nanoseconds {
+		days++
+		nsec -= day_nanoseconds
+	}
+	b := appendISODate(make([]byte, 0, 24), days)
+	b = append(b, 'T')
+	sec := nsec / 1e9
+	b = appendInt2(b, sec/3600)
+	b = append(b, ':')
+	b = appendInt2(b, sec/60%60)
+	b = append(b, ':')
+	b = appendInt2(b, sec%60)
+	b = append(b, '.')
+	b = append(b, strconv.FormatInt(nsec%1e9/1e6+1000, 10)[1:]...)
+	return string(append(b, 'Z'))
+}
+
+// appendISODate appends the ISO 8601 form of the date days after
+// January 1, 1970.
+func appendISODate(b []byte, days int64) []byte {
+	year, month, day := civilFromDays(days)
+	switch {
+	case year < 0:
+		b = fmt.Appendf(b, "-%04d", -int64(year))
+	case year > 9999:
+		b = fmt.Appendf(b, "+%04d", year)
+	default:
+		b = fmt.Appendf(b, "%04d", year)
+	}
+	b = append(b, '-')
+	b = appendInt2(b, int64(month))
+	b = append(b, '-')
+	return appendInt2(b, int64(day))
+}
+
+// ParseISO parses a proleptic Gregorian date and optional time of day in the
+// ISO 8601 extended format, as produced by ISODate and ISOString. The year is
+// either four digits or, in the expanded form, a sign followed by at least
+// four digits. Years are numbered astronomically, with a year 0, so
+// "-0044-03-15" is 45 BC (astronomical year -44). The time, if present,
+// follows a 'T' as "15:04", "15:04:05" or "15:04:05.999999999", and may be
+// followed by "Z" or a UTC offset such as "+09:00"; without one it is read
+// as UTC. A date without a time is midnight UTC.
+func ParseISO(s string) (Date, error) {
+	p := isoParser{s: s}
+	year := p.year()
+	p.expect('-')
+	month := p.digits(2)
+	p.expect('-')
+	day := p.digits(2)
+	var nsec int64
+	if p.ok && p.next('T') {
+		hour := p.digits(2)
+		p.expect(':')
+		min := p.digits(2)
+		var sec int
+		if p.next(':') {
+			sec = p.digits(2)
+			if p.next('.') || p.next(',') {
+				nsec = p.fraction()
+			}
+		}
+		if hour > 23 || min > 59 || sec > 59 {
+			p.ok = false
+		}
+		nsec += int64(hour*3600+min*60+sec) * 1e9
+		switch {
+		case p.next('Z'):
+		case p.peek('+') || p.peek('-'):
+			sign := int64(1)
+			if p.next('-') {
+				sign = -1
+			} else {
+				p.next('+')
+			}
+			oh := p.digits(2)
+			p.expect(':')
+			om := p.digits(2)
+			if oh > 23 || om > 59 {
+				p.ok = false
+			}
+			nsec -= sign * int64(oh*3600+om*60) * 1e9
+		}
+	}
+	if !p.ok || p.i != len(s) || month < 1 || month > 12 || day < 1 ||
+		day > daysIn(time.Month(month), year, ProlepticGregorian) {
+		return 0, fmt.Errorf("%w: %q", ErrSyntax, s)
+	}
+	return fromCivil(daysFromCivil(year, time.Month(month), day), nsec), nil
+}
+
+// isoParser scans an ISO 8601 string, clearing ok at the first error.
+type isoParser struct {
+	s  string
+	i  int
+	ok bool
+}
+
+func (p *isoParser) peek(c byte) bool {
+	return p.i < len(p.s) && p.s[p.i] == c
+}
+
+func (p *isoParser) next(c byte) bool {
+	if p.peek(c) {
+		p.i++
+		return true
+	}
+	return false
+}
+
+func (p *isoParser) expect(c byte) {
+	if !p.next(c) {
+		p.ok = false
+	}
+}
+
+// run returns the run of digits at the cursor and advances past it.
+func (p *isoParser) run() string {
+	j := p.i
+	for j < len(p.s) && '0' <= p.s[j] && p.s[j] <= '9' {
+		j++
+	}
+	r := p.s[p.i:j]
+	p.i = j
+	return r
+}
+
+// digits returns the value of exactly n digits at the cursor.
+func (p *isoParser) digits(n int) int {
+	r := p.run()
+	if len(r) != n {
+		p.ok = false
+		return 0
+	}
+	v, _ := strconv.Atoi(r)
+	return v
+}
+
+// year returns the year at the start of the string, four digits or a sign
+// and four or more digits.
+func (p *isoParser) year() int {
+	p.ok = true
+	sign := 1
+	signed := true
+	switch {
+	case p.next('-'):
+		sign = -1
+	case p.next('+'):
+	default:
+		signed = false
+	}
+	r := p.run()
+	if len(r) < 4 || !signed && len(r) != 4 {
+		p.ok = false
+		return 0
+	}
+	v, err := strconv.Atoi(r)
+	if err != nil || v > 1e9 {
+		p.ok = false
+		return 0
+	}
+	return sign * v
+}
+
+// fraction returns the nanoseconds of the fractional second digits at the
+// cursor, of which there must be one to nine.
+func (p *isoParser) fraction() int64 {
+	r := p.run()
+	if len(r) < 1 || len(r) > 9 {
+		p.ok = false
+		return 0
+	}
+	v, _ := strconv.ParseInt(r+strings.Repeat("0", 9-len(r)), 10, 64)
+	return v
+}
@@ -0,0 +1,96 @@
+package julian
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestJulianDate_ISOString(t *testing.T) {
+	tests := []struct {
+		name string
+		jd   Date
+		date string
+		str  string
+	}{
+		{"J2000", Date(2_451_545.0), "2000-01-01", "2000-01-01T12:00:00.000Z"},
+		{"45 BC", Date(1_705_062.5), "-0044-03-15", "-0044-03-15T00:00:00.000Z"},
+		{"year 0", Date(1_721_059.5), "0000-01-01", "0000-01-01T00:00:00.000Z"},
+		{"last four-digit day", Date(5_373_484.25), "9999-12-31", "9999-12-31T18:00:00.000Z"},
+		{"year 10000", Date(5_373_484.5), "+10000-01-01", "+10000-01-01T00:00:00.000Z"},
+		{"JD 0", Date(0), "-4713-11-24", "-4713-11-24T12:00:00.000Z"},
+		{"year -10000", Date(-1_931_365.5), "-10000-01-01", "-10000-01-01T00:00:00.000Z"},
+		{"milliseconds", Date(2_451_545.0) + Date(1.5/day_seconds), "2000-01-01", "2000-01-01T12:00:01.500Z"},
+		{"rounds to next day", Date(2_451_544.5) - Date(0.0003/day_seconds), "1999-12-31", "2000-01-01T00:00:00.000Z"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.jd.ISODate(); got != tt.date {
+				t.Errorf("JulianDate.ISODate() = %q, want %q", got, tt.date)
+			}
+			if got := tt.jd.ISOString(); got != tt.str {
+				t.Errorf("JulianDate.ISOString() = %q, want %q", got, tt.str)
+			}
+		})
+	}
+}
+
+func TestParseISO(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    Date
+		wantErr bool
+	}{
+		{"date", "2000-01-01", Date(2_451_544.5), false},
+		{"date and time", "2000-01-01T12:00:00Z", Date(2_451_545.0), false},
+		{"no zone", "2000-01-01T12:00:00", Date(2_451_545.0), false},
+		{"minutes", "2000-01-01T18:00", Date(2_451_545.25), false},
+		{"fraction", "2000-01-01T12:00:01.5Z", Date(2_451_545.0) + Date(1.5/day_seconds), false},
+		{"comma", "2000-01-01T12:00:01,5Z", Date(2_451_545.0) + Date(1.5/day_seconds), false},
+		{"offset", "2000-01-01T21:00:00+09:00", Date(2_451_545.0), false},
+		{"negative offset", "2000-01-01T06:30:00-05:30", Date(2_451_545.0), false},
+		{"expanded past", "-0044-03-15", Date(1_705_062.5), false},
+		{"expanded future", "+10000-01-01", Date(5_373_484.5), false},
+		{"expanded four digits", "+2000-01-01", Date(2_451_544.5), false},
+		{"year -10000", "-10000-01-01", Date(-1_931_365.5), false},
+		{"far future", "+123456-07-08", Date(46_812_626.5), false},
+		{"leap day", "2024-02-29", Date(2_460_369.5), false},
+		{"not a leap day", "2023-02-29", 0, true},
+		{"century leap", "-0100-02-29", 0, true},
+		{"five digits unsigned", "10000-01-01", 0, true},
+		{"three digits", "-044-03-15", 0, true},
+		{"month 13", "2000-13-01", 0, true},
+		{"day 0", "2000-01-00", 0, true},
+		{"hour 24", "2000-01-01T24:00:00Z", 0, true},
+		{"second 60", "2000-01-01T23:59:60Z", 0, true},
+		{"long fraction", "2000-01-01T12:00:00.1234567890Z", 0, true},
+		{"empty fraction", "2000-01-01T12:00:00.Z", 0, true},
+		{"trailing", "2000-01-01Tx", 0, true},
+		{"basic format", "20000101", 0, true},
+		{"empty", "", 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseISO(tt.s)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseISO(%q) error = %v, wantErr %v", tt.s, err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrSyntax) {
+				t.Errorf("ParseISO(%q) error = %v, want ErrSyntax", tt.s, err)
+			}
+			if !equalJulian(got, tt.want) {
+				t.Errorf("ParseISO(%q) = %f, want %f", tt.s, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseISO_roundTrip(t *testing.T) {
+	for jd := Date(-5_000_000.5); jd < 50_000_000; jd += 99_991.125 {
+		s := jd.ISOString()
+		got, err := ParseISO(s)
+		if err != nil || !equalJulian(got, jd) {
+			t.Fatalf("ParseISO(%q) = %f, %v, want %f", s, got, err, jd)
+		}
+	}
+}
@@ -0,0 +1,156 @@
+package julian
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// A JDTime is an exact julian date held as the julian day number and the
+// nanoseconds elapsed since the noon UTC that begins it. Unlike Date, a
+// JDTime represents every time.Time exactly, so it may be compared with ==
+// and used as a map key when normalized.
+//
+// A normalized JDTime, as returned by this package, has Nanos in the range
+// [0, 86400e9).
+type JDTime struct {
+	Day   int64
+	Nanos int64
+}
+
+// JDTimeOf returns the exact julian time of t.
+func JDTimeOf(t time.Time) JDTime {
+	sec := t.Unix()
+	days := floorDiv(sec, day_seconds)
+	nsec := (sec-days*day_seconds)*1e9 + int64(t.Nanosecond())
+	return JDTime{days + jdn_unix, nsec - day_nanoseconds/2}.Normalize()
+}
+
+// JDTime returns jd as a JDTime, rounded to the nearest nanosecond.
+func (jd Date) JDTime() JDTime {
+	days, nsec := jd.civil()
+	return JDTime{days + jdn_unix, nsec - day_nanoseconds/2}.Normalize()
+}
+
+// Normalize returns t with any whole days in Nanos carried into Day.
+func (t JDTime) Normalize() JDTime {
+	return JDTime{t.Day + floorDiv(t.Nanos, day_nanoseconds), floorMod(t.Nanos, day_nanoseconds)}
+}
+
+// Date returns t as a julian date, with the loss of precision that implies.
+func (t JDTime) Date() Date {
+	n := t.Normalize()
+	return Date(float64(n.Day) + float64(n.Nanos)/day_nanoseconds)
+}
+
+// Gregorian returns t as a time.Time in the local time zone.
+func (t JDTime) Gregorian() time.Time {
+	n := t.Normalize()
+	nsec := n.Nanos + day_nanoseconds/2
+	return time.Unix((n.Day-jdn_unix)*day_seconds+nsec/1e9, nsec%1e9)
+}
+
+// UTC returns t as a time.Time in UTC.
+func (t JDTime) UTC() time.Time {
+	return t.Gregorian().UTC()
+}
+
+// Add returns the julian time t+d.
+func (t JDTime) Add(d time.Duration) JDTime {
+	n := t.Normalize()
+	days := int64(d) / day_nanoseconds
+	return JDTime{n.Day + days, n.Nanos + int64(d) - days*day_nanoseconds}.Normalize()
+}
+
+// Sub returns the duration t-u. If the result exceeds the maximum (or minimum)
+// value that can be stored in a Duration, the maximum (or minimum) duration
+// will be returned.
+func (t JDTime) Sub(u JDTime) time.Duration {
+	a, b := t.Normalize(), u.Normalize()
+	days, d := a.Day-b.Day, a.Nanos-b.Nanos
+	// give the days and nanoseconds the same sign, so that the days alone
+	// overflow exactly when the product below would
+	switch {
+	case days > 0 && d < 0:
+		days, d = days-1, d+day_nanoseconds
+	case days < 0 && d > 0:
+		days, d = days+1, d-day_nanoseconds
+	}
+	switch {
+	case days > math.MaxInt64/day_nanoseconds:
+		return math.MaxInt64
+	case days < math.MinInt64/day_nanoseconds:
+		return math.MinInt64
+	}
+	ns := days * day_nanoseconds
+	switch {
+	case d > 0 && ns > math.MaxInt64-d:
+		return math.MaxInt64
+	case d < 0 && ns < math.MinInt64-d:
+		return math.MinInt64
+	}
+	return time.Duration(ns + d)
+}
+
+// Compare compares t and u. The result is -1 if t is before u, 0 if they are
+// the same instant and +1 if t is after u.
+func (t JDTime) Compare(u JDTime) int {
+	a, b := t.Normalize(), u.Normalize()
+	if c := cmp.Compare(a.Day, b.Day); c != 0 {
+		return c
+	}
+	return cmp.Compare(a.Nanos, b.Nanos)
+}
+
+// Equal reports whether t and u are the same instant.
+func (t JDTime) Equal(u JDTime) bool {
+	return t.Normalize() == u.Normalize()
+}
+
+// Before reports whether t is before u.
+func (t JDTime) Before(u JDTime) bool {
+	return t.Compare(u) < 0
+}
+
+// After reports whether t is after u.
+func (t JDTime) After(u JDTime) bool {
+	return t.Compare(u) > 0
+}
+
+// String returns t formatted as the julian day and the nanoseconds into it,
+// e.g. "2451545:43200000000000".
+func (t JDTime) String() string {
+	b, _ := t.MarshalText()
+	return string(b)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface. The time is
+// formatted as String does.
+func (t JDTime) MarshalText() ([]byte, error) {
+	n := t.Normalize()
+	b := strconv.AppendInt(make([]byte, 0, 32), n.Day, 10)
+	b = append(b, ':')
+	return strconv.AppendInt(b, n.Nanos, 10), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface. The time
+// must be in the format produced by MarshalText.
+func (t *JDTime) UnmarshalText(data []byte) error {
+	day, nanos, ok := strings.Cut(string(data), ":")
+	if !ok {
+		return fmt.Errorf("%w: %q", ErrSyntax, data)
+	}
+	d, err := strconv.ParseInt(day, 10, 64)
+	if err != nil {
+		return fmt.Errorf("%w: %q", ErrSyntax, data)
+	}
+	n, err := strconv.ParseInt(nanos, 10, 64)
+	if err != nil {
+		return fmt.Errorf("%w: %q", ErrSyntax, data)
+	}
+	*t = JDTime{d, n}.Normalize()
+	return nil
+}
@@ -0,0 +1,155 @@
+package julian
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestJDTimeOf(t *testing.T) {
+	tests := []struct {
+		name string
+		t    time.Time
+		want JDTime
+	}{
+		{"J2000", time.Date(2000, time.January, 1, 12, 0, 0, 0, time.UTC), JDTime{2_451_545, 0}},
+		{"morning", time.Date(2000, time.January, 1, 6, 0, 0, 1, time.UTC), JDTime{2_451_544, 18*3600e9 + 1}},
+		{"unix", time.Unix(0, 0), JDTime{2_440_587, 12 * 3600e9}},
+		{"year 1000", time.Date(1000, time.January, 1, 18, 0, 0, 0, time.UTC), JDTime{2_086_303, 6 * 3600e9}},
+		{"zero time", time.Time{}, JDTime{1_721_425, 12 * 3600e9}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := JDTimeOf(tt.t)
+			if got != tt.want {
+				t.Errorf("JDTimeOf() = %v, want %v", got, tt.want)
+			}
+			if back := got.UTC(); !back.Equal(tt.t) {
+				t.Errorf("JDTime.UTC() = %v, want %v", back, tt.t)
+			}
+		})
+	}
+}
+
+func TestJDTime_Date(t *testing.T) {
+	jd := Date(2_455_241.7229166)
+	got := jd.JDTime()
+	if got.Day != 2_455_241 {
+		t.Errorf("JulianDate.JDTime().Day = %d, want 2455241", got.Day)
+	}
+	if !equalJulian(got.Date(), jd) {
+		t.Errorf("JDTime.Date() = %v, want %v", got.Date(), jd)
+	}
+}
+
+func TestJDTime_Normalize(t *testing.T) {
+	tests := []struct {
+		name string
+		t    JDTime
+		want JDTime
+	}{
+		{"normal", JDTime{10, 5}, JDTime{10, 5}},
+		{"negative", JDTime{10, -1}, JDTime{9, day_nanoseconds - 1}},
+		{"overflowing", JDTime{10, day_nanoseconds + 2}, JDTime{11, 2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.t.Normalize(); got != tt.want {
+				t.Errorf("JDTime.Normalize() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJDTime_AddSub(t *testing.T) {
+	start := JDTime{2_451_545, 1}
+	tests := []struct {
+		name string
+		d    time.Duration
+		want JDTime
+	}{
+		{"nanosecond", 1, JDTime{2_451_545, 2}},
+		{"back a day", -24*time.Hour - 2, JDTime{2_451_543, day_nanoseconds - 1}},
+		{"carry", 36 * time.Hour, JDTime{2_451_546, 12*3600e9 + 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := start.Add(tt.d)
+			if got != tt.want {
+				t.Errorf("JDTime.Add(%v) = %v, want %v", tt.d, got, tt.want)
+			}
+			if back := got.Sub(start); back != tt.d {
+				t.Errorf("JDTime.Sub() = %v, want %v", back, tt.d)
+			}
+		})
+	}
+	if got := (JDTime{start.Day + 200_000, 0}).Sub(start); got != math.MaxInt64 {
+		t.Errorf("JDTime.Sub() = %v, want saturated", got)
+	}
+}
+
+func TestJDTime_SubLimits(t *testing.T) {
+	const limit = math.MaxInt64 / day_nanoseconds // 106751 whole days
+	const rest = math.MaxInt64 - limit*day_nanoseconds
+	zero := JDTime{}
+	tests := []struct {
+		name string
+		t, u JDTime
+		want time.Duration
+	}{
+		{"whole days", JDTime{limit, 0}, zero, limit * day_nanoseconds},
+		{"less half a day", JDTime{limit, 0}, JDTime{0, day_nanoseconds / 2}, limit*day_nanoseconds - day_nanoseconds/2},
+		{"day after limit less half a day", JDTime{limit + 1, 0}, JDTime{0, day_nanoseconds / 2}, limit*day_nanoseconds + day_nanoseconds/2},
+		{"maximum", JDTime{limit, rest}, zero, math.MaxInt64},
+		{"past maximum", JDTime{limit, rest + 1}, zero, math.MaxInt64},
+		{"day after limit", JDTime{limit + 1, 0}, zero, math.MaxInt64},
+		{"negative whole days", zero, JDTime{limit, 0}, -limit * day_nanoseconds},
+		{"negative day after limit", JDTime{0, day_nanoseconds / 2}, JDTime{limit + 1, 0}, -limit*day_nanoseconds - day_nanoseconds/2},
+		{"minimum", zero, JDTime{limit, rest + 1}, math.MinInt64},
+		{"past minimum", zero, JDTime{limit, rest + 2}, math.MinInt64},
+		{"negative past limit", zero, JDTime{limit + 1, 0}, math.MinInt64},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.t.Sub(tt.u); got != tt.want {
+				t.Errorf("%v.Sub(%v) = %v, want %v", tt.t, tt.u, int64(got), int64(tt.want))
+			}
+		})
+	}
+}
+
+func TestJDTime_Compare(t *testing.T) {
+	a, b := JDTime{10, 5}, JDTime{9, day_nanoseconds + 5}
+	if !a.Equal(b) || a.Compare(b) != 0 {
+		t.Errorf("%v and %v should be equal", a, b)
+	}
+	c := JDTime{10, 6}
+	if !a.Before(c) || !c.After(a) || a.Compare(c) != -1 || c.Compare(a) != 1 {
+		t.Errorf("%v should be before %v", a, c)
+	}
+}
+
+func TestJDTime_Text(t *testing.T) {
+	in := JDTime{2_451_545, 123_456_789}
+	b, err := json.Marshal(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `"2451545:123456789"`; string(b) != want {
+		t.Errorf("json.Marshal() = %s, want %s", b, want)
+	}
+	var got JDTime
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got != in {
+		t.Errorf("json.Unmarshal() = %v, want %v", got, in)
+	}
+	for _, s := range []string{"", "2451545", "x:1", "1:y"} {
+		if err := got.UnmarshalText([]byte(s)); !errors.Is(err, ErrSyntax) {
+			t.Errorf("JDTime.UnmarshalText(%q) error = %v, want ErrSyntax", s, err)
+		}
+	}
+}
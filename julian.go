@@ -8,24 +8,64 @@
 package julian
 
 import (
+	"cmp"
+	"errors"
 	"math"
+	"slices"
 	"time"
 )
 
 type Date float64
 
 const (
-	day_seconds     = 86400
-	day_nanoseconds = day_seconds * 1_000_000_000
-	julian_unix     = 2440587.5 // 1/1/1970
-	days_p_century  = 36525
-	epoch_j2000     = 2451545
+	day_seconds       = 86400
+	day_nanoseconds   = day_seconds * 1_000_000_000
+	julian_unix       = 2440587.5 // 1/1/1970
+	julian_zero       = 1721425.5 // 1/1/0001, the zero time.Time
+	jdn_unix          = 2440588   // day number of 1/1/1970
+	mjd_unix          = 40587     // modified julian day of 1/1/1970
+	days_p_century    = 36525
+	days_p_millennium = 365250
+	epoch_j2000       = 2451545
+	epoch_b1900       = 2415020.31352
+	days_p_year       = 365.25        // julian year
+	days_p_trop_year  = 365.242198781 // besselian (tropical) year
 )
 
-// Time returns a julian date version of the time.
+// ErrRange indicates that a julian date is out of the range of the requested
+// representation.
+var ErrRange = errors.New("julian: value out of range")
+
+// Time returns a julian date version of the time. It is valid for any
+// time.Time, including those outside the range of UnixNano.
+//
+// Any monotonic clock reading is stripped first, so times that are equal as
+// wall clock instants, whatever their location, give identical julian dates.
 func Time(t time.Time) Date {
-	j := float64(t.UnixNano())/day_nanoseconds + julian_unix
-	return Date(j)
+	t = t.Round(0)
+	sec := t.Unix()
+	if -max_unix_nano_sec < sec && sec < max_unix_nano_sec {
+		return Date(float64(t.UnixNano())/day_nanoseconds + julian_unix)
+	}
+	days := floorDiv(sec, day_seconds)
+	return fromCivil(days, (sec-days*day_seconds)*1e9+int64(t.Nanosecond()))
+}
+
+// TimeExact is like Time but also returns the residual lost in rounding t to
+// a float64 julian date, so that jd.Gregorian().Add(residual) equals t
+// exactly.
+func TimeExact(t time.Time) (jd Date, residual time.Duration) {
+	jd = Time(t)
+	return jd, t.Sub(jd.Gregorian())
+}
+
+// max_unix_nano_sec bounds the Unix seconds whose UnixNano fits in an int64.
+const max_unix_nano_sec = math.MaxInt64/1_000_000_000 - 1
+
+// Now returns the julian date of the current time. As with Time, the result
+// depends only on the wall clock.
+func Now() Date {
+	return Time(time.Now())
 }
 
 // NewDate returns the julian date corresponding to yyyy-mm-dd hh:mm:ss + nsec
@@ -44,19 +84,101 @@ func Time(t time.Time) Date {
 //
 // NewDate panics if loc is nil.
 func NewDate(year int, month time.Month, day, hour, min, sec, nsec int, loc *time.Location) Date {
-	t := time.Date(year, month, day, hour, min, sec, nsec, loc)
-	jd := float64(t.UnixNano())/day_nanoseconds + julian_unix
-	return Date(jd)
+	return Time(time.Date(year, month, day, hour, min, sec, nsec, loc))
+}
+
+// FromDayNumber returns the julian date frac of a day after the start of the
+// julian day jdn, which begins at noon UTC. It is the inverse of Split when
+// frac is in [0, 1).
+func FromDayNumber(jdn int64, frac float64) Date {
+	return Date(float64(jdn) + frac)
+}
+
+// FromJDN returns the julian date at the start of the julian day with the
+// given number, noon UTC of the calendar day whose JDN is jdn.
+func FromJDN(jdn int64) Date {
+	return Date(jdn)
+}
+
+// FromUnix returns the julian date corresponding to the given Unix time, sec
+// seconds and nsec nanoseconds since January 1, 1970 UTC. It is valid to pass
+// nsec outside the range [0, 999999999].
+func FromUnix(sec int64, nsec int64) Date {
+	sec += floorDiv(nsec, 1e9)
+	nsec = floorMod(nsec, 1e9)
+	days := floorDiv(sec, day_seconds)
+	return fromCivil(days, (sec-days*day_seconds)*1e9+nsec)
+}
+
+// FromUnixMilli returns the julian date corresponding to the given Unix time,
+// msec milliseconds since January 1, 1970 UTC.
+func FromUnixMilli(msec int64) Date {
+	return FromUnix(floorDiv(msec, 1e3), floorMod(msec, 1e3)*1e6)
 }
 
-// Gregorian
+// Gregorian returns the julian date as a time.Time in the local time zone.
+// Use GregorianIn or UTC to choose the zone explicitly.
+//
+// Dates outside the range of UnixNano are converted through the proleptic
+// Gregorian calendar, so any julian date within the range of time.Time is
+// valid.
 func (jd Date) Gregorian() time.Time {
-	return time.Unix(0, jd.UnixNano())
+	days, nsec := jd.civil()
+	if -max_unix_days_nano < days && days < max_unix_days_nano {
+		return time.Unix(0, days*day_nanoseconds+nsec)
+	}
+	y, m, d := civilFromDays(days)
+	return time.Date(y, m, d, 0, 0, 0, int(nsec), time.UTC).Local()
 }
 
-// Unix returns the Unix time corresponding to the julian date
+// max_unix_days_nano bounds the days since 1970 whose UnixNano fits in an int64.
+const max_unix_days_nano = math.MaxInt64/day_nanoseconds - 1
+
+// GregorianChecked is like Gregorian but returns ErrRange if jd is NaN, an
+// infinity, or outside the range of time.Time, rather than a meaningless
+// time.
+func (jd Date) GregorianChecked() (time.Time, error) {
+	if !jd.IsValid() || math.Abs(float64(jd-julian_unix)) >= max_gregorian_days {
+		return time.Time{}, ErrRange
+	}
+	return jd.Gregorian(), nil
+}
+
+// max_gregorian_days bounds the days since 1970 of a time.Time, whose seconds
+// are counted in an int64 from January 1, year 1.
+const max_gregorian_days = max_unix_days - 719_163
+
+// GregorianIn returns the julian date as a time.Time in the given location.
+//
+// GregorianIn panics if loc is nil.
+func (jd Date) GregorianIn(loc *time.Location) time.Time {
+	return jd.Gregorian().In(loc)
+}
+
+// UTC returns the julian date as a time.Time in UTC.
+func (jd Date) UTC() time.Time {
+	return jd.Gregorian().UTC()
+}
+
+// Unix returns the julian date as a Unix time, the number of seconds elapsed
+// since January 1, 1970 UTC, rounded down to a whole second.
 func (jd Date) Unix() int64 {
-	return int64((jd - julian_unix) * day_seconds)
+	days, nsec := jd.civil()
+	return days*day_seconds + nsec/1e9
+}
+
+// UnixMilli returns the julian date as a Unix time, the number of milliseconds
+// elapsed since January 1, 1970 UTC.
+func (jd Date) UnixMilli() int64 {
+	days, nsec := jd.civil()
+	return days*day_seconds*1e3 + nsec/1e6
+}
+
+// UnixMicro returns the julian date as a Unix time, the number of microseconds
+// elapsed since January 1, 1970 UTC.
+func (jd Date) UnixMicro() int64 {
+	days, nsec := jd.civil()
+	return days*day_seconds*1e6 + nsec/1e3
 }
 
 // UnixNano returns julian date as a Unix time, the number of nanoseconds elapsed
@@ -67,17 +189,111 @@ func (jd Date) Unix() int64 {
 // the result of calling UnixNano on the zero Time is undefined. The result does
 // not depend on the location associated with j.
 func (jd Date) UnixNano() int64 {
-	return int64((jd - julian_unix) * day_nanoseconds)
+	days, nsec := jd.civil()
+	return days*day_nanoseconds + nsec
+}
+
+// IsValid reports whether jd is a finite number, that is, neither NaN nor an
+// infinity.
+func (jd Date) IsValid() bool {
+	return !math.IsNaN(float64(jd)) && !math.IsInf(float64(jd), 0)
 }
 
-// Time returns the time fraction.
+// IsZero reports whether jd is the zero value, JD 0.0. The zero value is noon
+// UTC on January 1, 4713 BC in the julian calendar, a date that rarely occurs
+// in practice, so it may be used as the sentinel for an unset date.
+func (jd Date) IsZero() bool {
+	return jd == 0
+}
+
+// UnixChecked is like Unix but returns ErrRange if jd is not valid or is
+// outside the range of an int64 count of seconds.
+func (jd Date) UnixChecked() (int64, error) {
+	return jd.unixChecked(1e9)
+}
+
+// UnixMilliChecked is like UnixMilli but returns ErrRange if jd is not valid
+// or is outside the range of an int64 count of milliseconds.
+func (jd Date) UnixMilliChecked() (int64, error) {
+	return jd.unixChecked(1e6)
+}
+
+// UnixMicroChecked is like UnixMicro but returns ErrRange if jd is not valid
+// or is outside the range of an int64 count of microseconds.
+func (jd Date) UnixMicroChecked() (int64, error) {
+	return jd.unixChecked(1e3)
+}
+
+// UnixNanoChecked is like UnixNano but returns ErrRange instead of an
+// undefined result if jd is not valid or is outside the range of an int64
+// count of nanoseconds, a date before the year 1678 or after 2262.
+func (jd Date) UnixNanoChecked() (int64, error) {
+	return jd.unixChecked(1)
+}
+
+const max_unix_days = math.MaxInt64 / day_seconds
+
+// unixChecked returns jd as a Unix time in multiples of unit nanoseconds.
+func (jd Date) unixChecked(unit int64) (int64, error) {
+	if !jd.IsValid() || math.Abs(float64(jd-julian_unix)) > max_unix_days {
+		return 0, ErrRange
+	}
+	days, nsec := jd.civil()
+	if n, ok := scaleChecked(days, day_nanoseconds/unit, nsec/unit); ok {
+		return n, nil
+	}
+	return 0, ErrRange
+}
+
+// scaleChecked returns days*perDay+q for q in [0, perDay), reporting whether
+// the result fits in an int64.
+func scaleChecked(days, perDay, q int64) (int64, bool) {
+	switch {
+	case days > math.MaxInt64/perDay:
+		return 0, false
+	case days == math.MinInt64/perDay-1:
+		// days*perDay alone underflows but q may bring it back in range
+		n := (days + 1) * perDay
+		if n-math.MinInt64 < perDay-q {
+			return 0, false
+		}
+		return n - (perDay - q), true
+	case days < math.MinInt64/perDay:
+		return 0, false
+	}
+	n := days * perDay
+	if n > math.MaxInt64-q {
+		return 0, false
+	}
+	return n + q, true
+}
+
+// Split returns the julian day containing jd, which begins at noon UTC, and
+// the fraction of that day elapsed at jd. The fraction is always in [0, 1),
+// including for negative julian dates.
+func (jd Date) Split() (day int64, frac float64) {
+	d := math.Floor(float64(jd))
+	frac = float64(jd) - d
+	if frac >= 1 {
+		// a tiny negative fraction rounds up to a whole day
+		return int64(d) + 1, 0
+	}
+	return int64(d), frac
+}
+
+// Time returns the time fraction, the part of the julian day elapsed since the
+// preceding noon UTC, in [0, 1). It is the fraction returned by Split, so it
+// is also correct for negative julian dates.
 func (jd Date) Time() float64 {
-	return math.Mod(float64(jd), 1)
+	_, frac := jd.Split()
+	return frac
 }
 
-// Duration returns the time fraction.
+// Duration returns the time fraction as a duration since the preceding noon
+// UTC.
 func (jd Date) Duration() time.Duration {
-	return time.Duration(day_nanoseconds * math.Mod(float64(jd), 1))
+	_, frac := jd.Split()
+	return time.Duration(day_nanoseconds * frac)
 }
 
 // Day returns the float64 representation of the Julian day.
@@ -86,11 +302,305 @@ func (jd Date) Day() float64 {
 }
 
 // DayNumber returns the integer part of the Julian day.
+//
+// Deprecated: DayNumber truncates toward zero, which is not the conventional
+// julian day number of the calendar day containing jd. Use JDN for that, or
+// TruncatedDay for the same result as DayNumber.
 func (jd Date) DayNumber() int {
 	return int(jd)
 }
 
+// TruncatedDay returns the integer part of the julian date, truncated toward
+// zero.
+func (jd Date) TruncatedDay() int64 {
+	return int64(jd)
+}
+
+// JDN returns the julian day number of the UTC calendar day containing jd,
+// floor(jd+0.5). It is the number of the julian day that begins at noon of
+// that calendar day, so both midnight and noon on January 1, 2000 have the
+// julian day number 2451545.
+func (jd Date) JDN() int64 {
+	return int64(math.Floor(float64(jd) + 0.5))
+}
+
+// CivilDayNumber returns the julian day number of the calendar day containing
+// jd in the given location, which begins at local midnight rather than
+// midnight UTC.
+//
+// CivilDayNumber panics if loc is nil.
+func (jd Date) CivilDayNumber(loc *time.Location) int64 {
+	y, m, d := jd.GregorianIn(loc).Date()
+	return daysFromCivil(y, m, d) + jdn_unix
+}
+
+// DayNumberOfTime returns the julian day number of the UTC calendar day
+// containing t. It agrees with Time(t).JDN() but uses only integer
+// arithmetic, so it is exact even in the last microseconds of a day.
+func DayNumberOfTime(t time.Time) int64 {
+	return floorDiv(t.Unix(), day_seconds) + jdn_unix
+}
+
+// MJDOfTime returns the integer modified julian day of the UTC calendar day
+// containing t, which begins at midnight UTC. It uses only integer arithmetic.
+func MJDOfTime(t time.Time) int64 {
+	return floorDiv(t.Unix(), day_seconds) + mjd_unix
+}
+
 // Century returns the Julian century.
 func (jd Date) Century() float64 {
 	return float64(jd-epoch_j2000) / days_p_century
 }
+
+// Millennium returns the number of Julian millennia since J2000.0.
+func (jd Date) Millennium() float64 {
+	return float64(jd-epoch_j2000) / days_p_millennium
+}
+
+// JulianEpoch returns the julian epoch of jd, the year J2000.0 plus the
+// number of julian years of 365.25 days since J2000.0. For example, JD
+// 2457206.375 is J2015.5.
+func (jd Date) JulianEpoch() float64 {
+	return 2000 + float64(jd-epoch_j2000)/days_p_year
+}
+
+// FromJulianEpoch returns the julian date of the julian epoch e.
+func FromJulianEpoch(e float64) Date {
+	return epoch_j2000 + Date((e-2000)*days_p_year)
+}
+
+// BesselianEpoch returns the besselian epoch of jd, counted in tropical
+// years from B1900.0. For example, B1950.0 is JD 2433282.4235.
+func (jd Date) BesselianEpoch() float64 {
+	return 1900 + float64(jd-epoch_b1900)/days_p_trop_year
+}
+
+// FromBesselianEpoch returns the julian date of the besselian epoch e.
+func FromBesselianEpoch(e float64) Date {
+	return epoch_b1900 + Date((e-1900)*days_p_trop_year)
+}
+
+// DaysSinceJ2000 returns the number of days since J2000.0.
+func (jd Date) DaysSinceJ2000() float64 {
+	return float64(jd - epoch_j2000)
+}
+
+// Compare compares the julian date jd with other. If jd is before other, it
+// returns -1; if jd is after other, it returns +1; if they're the same, it
+// returns 0. A NaN is considered less than any other value.
+func (jd Date) Compare(other Date) int {
+	return cmp.Compare(jd, other)
+}
+
+// Before reports whether the julian date jd is before other.
+func (jd Date) Before(other Date) bool {
+	return jd < other
+}
+
+// After reports whether the julian date jd is after other.
+func (jd Date) After(other Date) bool {
+	return jd > other
+}
+
+// Equal reports whether jd and other represent the same instant.
+func (jd Date) Equal(other Date) bool {
+	return jd == other
+}
+
+// Min returns the earlier of a and b. If either is NaN, the result is NaN.
+func Min(a, b Date) Date {
+	return min(a, b)
+}
+
+// Max returns the later of a and b. If either is NaN, the result is NaN.
+func Max(a, b Date) Date {
+	return max(a, b)
+}
+
+// MinOf returns the earliest of the dates. If any is NaN, the result is NaN.
+// MinOf panics if no dates are given.
+func MinOf(dates ...Date) Date {
+	return slices.Min(dates)
+}
+
+// MaxOf returns the latest of the dates. If any is NaN, the result is NaN.
+// MaxOf panics if no dates are given.
+func MaxOf(dates ...Date) Date {
+	return slices.Max(dates)
+}
+
+// Clamp returns jd limited to the range [lo, hi]. The result is undefined if
+// lo is after hi.
+func (jd Date) Clamp(lo, hi Date) Date {
+	return min(max(jd, lo), hi)
+}
+
+// ApproxEqual reports whether jd and other are within tol of each other.
+// It is false if either value is NaN.
+func (jd Date) ApproxEqual(other Date, tol time.Duration) bool {
+	return math.Abs(float64(jd-other))*day_nanoseconds <= float64(tol)
+}
+
+// Add returns the julian date jd+d.
+func (jd Date) Add(d time.Duration) Date {
+	return jd + Date(float64(d)/day_nanoseconds)
+}
+
+// AddDays returns the julian date jd advanced by the given number of days,
+// which may be fractional or negative.
+func (jd Date) AddDays(days float64) Date {
+	return jd + Date(days)
+}
+
+// Days returns the duration of n days. It saturates at the limits of
+// time.Duration, about 106751 days.
+func Days(n float64) time.Duration {
+	ns := n * day_nanoseconds
+	switch {
+	case ns >= math.MaxInt64:
+		return math.MaxInt64
+	case ns <= math.MinInt64:
+		return math.MinInt64
+	}
+	return time.Duration(ns)
+}
+
+// AddDate returns the julian date corresponding to adding the given number of
+// years, months, and days to jd. For example, AddDate(-1, 2, 3) applied to
+// January 1, 2011 returns March 4, 2010. The time of day is unchanged.
+//
+// The calendar arithmetic is done on the proleptic Gregorian UTC date and
+// normalizes its result in the same way that time.Time.AddDate does, so, for
+// example, adding one month to October 31 yields December 1.
+func (jd Date) AddDate(years int, months int, days int) Date {
+	y, m, d := jd.Date()
+	mm := int64(m) - 1 + int64(months)
+	y1 := y + years + int(floorDiv(mm, 12))
+	m1 := time.Month(floorMod(mm, 12) + 1)
+	return jd + Date(daysFromCivil(y1, m1, d+days)-daysFromCivil(y, m, d))
+}
+
+// Sub returns the duration jd-other. If the result exceeds the maximum (or
+// minimum) value that can be stored in a Duration, the maximum (or minimum)
+// duration will be returned.
+//
+// The difference is taken in days before it is scaled to nanoseconds, so
+// nearby dates do not lose precision to the large julian day offset. Use
+// Diff for the difference in days as a Delta.
+func (jd Date) Sub(other Date) time.Duration {
+	return Days(float64(jd - other))
+}
+
+// SubDays returns the number of days jd-other. Unlike Sub, the result does not
+// overflow for intervals longer than about 292 years.
+func (jd Date) SubDays(other Date) float64 {
+	return float64(jd - other)
+}
+
+// Truncate returns the result of rounding jd down to a multiple of d. As with
+// time.Time, multiples are counted from midnight UTC on January 1, year 1,
+// rather than from the noon that starts the julian day, so
+// Truncate(time.Hour) yields a whole UTC hour and Truncate(24*time.Hour)
+// yields the preceding UTC midnight. If d <= 0, Truncate returns jd
+// unchanged.
+func (jd Date) Truncate(d time.Duration) Date {
+	if d <= 0 {
+		return jd
+	}
+	if day_nanoseconds%d != 0 {
+		n := math.Floor(float64(jd-julian_zero) * day_nanoseconds / float64(d))
+		return julian_zero + Date(n*float64(d)/day_nanoseconds)
+	}
+	days, nsec := jd.civil()
+	return fromCivil(days, nsec-nsec%int64(d))
+}
+
+// Round returns the result of rounding jd to the nearest multiple of d, with
+// multiples counted from midnight UTC as for Truncate. The rounding behavior
+// for halfway values is to round up. If d <= 0, Round returns jd unchanged.
+func (jd Date) Round(d time.Duration) Date {
+	if d <= 0 {
+		return jd
+	}
+	if day_nanoseconds%d != 0 {
+		n := math.Floor(float64(jd-julian_zero)*day_nanoseconds/float64(d) + 0.5)
+		return julian_zero + Date(n*float64(d)/day_nanoseconds)
+	}
+	days, nsec := jd.civil()
+	r := nsec % int64(d)
+	if r+r < int64(d) {
+		return fromCivil(days, nsec-r)
+	}
+	return fromCivil(days, nsec+int64(d)-r)
+}
+
+// Key returns jd as a count of res intervals since January 1, 1970 UTC,
+// rounded to the nearest interval. Dates that differ only by floating-point
+// noise smaller than res/2 have the same key, so it can be used as a map or
+// deduplication key. If res <= 0, a resolution of one nanosecond is used.
+//
+// The result is undefined if the count cannot be represented by an int64,
+// which for a resolution of one nanosecond is a date before the year 1678
+// or after 2262.
+func (jd Date) Key(res time.Duration) int64 {
+	res = max(res, time.Nanosecond)
+	if day_nanoseconds%res != 0 {
+		return int64(math.Floor(float64(jd-julian_unix)*day_nanoseconds/float64(res) + 0.5))
+	}
+	days, nsec := jd.civil()
+	return days*int64(day_nanoseconds/res) + (nsec+int64(res)/2)/int64(res)
+}
+
+// Quantize returns jd rounded to the nearest multiple of res since January 1,
+// 1970 UTC. Dates with the same Key quantize to exactly the same value, so the
+// result is canonical for the resolution. If res <= 0, a resolution of one
+// nanosecond is used.
+func (jd Date) Quantize(res time.Duration) Date {
+	res = max(res, time.Nanosecond)
+	k := jd.Key(res)
+	if day_nanoseconds%res != 0 {
+		return julian_unix + Date(float64(k)*float64(res)/day_nanoseconds)
+	}
+	perDay := int64(day_nanoseconds / res)
+	days := floorDiv(k, perDay)
+	return fromCivil(days, (k-days*perDay)*int64(res))
+}
+
+// civil splits jd into the number of days since January 1, 1970 and the
+// nanoseconds since the preceding midnight UTC.
+func (jd Date) civil() (days int64, nsec int64) {
+	m := float64(jd - julian_unix)
+	d := math.Floor(m)
+	days = int64(d)
+	nsec = int64(math.Round((m - d) * day_nanoseconds))
+	if nsec >= day_nanoseconds {
+		days++
+		nsec -= day_nanoseconds
+	}
+	return days, nsec
+}
+
+// fromCivil is the inverse of civil.
+func fromCivil(days int64, nsec int64) Date {
+	return julian_unix + Date(days) + Date(float64(nsec)/day_nanoseconds)
+}
+
+// StartOfJulianDay returns the start of the julian day containing jd, which is
+// the preceding noon UTC.
+func (jd Date) StartOfJulianDay() Date {
+	return Date(math.Floor(float64(jd)))
+}
+
+// NoonUTC returns noon UTC of the UTC calendar day containing jd.
+func (jd Date) NoonUTC() Date {
+	return Date(math.Floor(float64(jd) + 0.5))
+}
+
+// StartOfCivilDay returns the first instant of the calendar day containing jd
+// in the given location, normally midnight local time.
+//
+// StartOfCivilDay panics if loc is nil.
+func (jd Date) StartOfCivilDay(loc *time.Location) Date {
+	y, m, d := jd.GregorianIn(loc).Date()
+	return Time(time.Date(y, m, d, 0, 0, 0, 0, loc))
+}
@@ -1,6 +1,7 @@
 package julian
 
 import (
+	"errors"
 	"math"
 	"testing"
 	"time"
@@ -47,6 +48,9 @@ func TestJulian(t *testing.T) {
 		{"July 4, 1998", args{time.Date(1998, time.July, 4, 0, 0, 0, 0, time.UTC)}, Date(2_450_998.50000)},
 		{"Feb. 14, 2010 5:21", args{time.Date(2010, time.February, 14, 5, 21, 0, 0, time.UTC)}, Date(2_455_241.722917)},
 		{"Feb. 14, 2010 5:21 PST", args{time.Date(2010, time.February, 14, 5, 21, 0, 0, location)}, Date(2_455_242.05625)},
+		{"Jan. 1, 1000", args{time.Date(1000, time.January, 1, 0, 0, 0, 0, time.UTC)}, Date(2_086_302.5)},
+		{"JD 0", args{time.Date(-4713, time.November, 24, 12, 0, 0, 0, time.UTC)}, Date(0)},
+		{"Jan. 1, 3000 6:00", args{time.Date(3000, time.January, 1, 6, 0, 0, 0, time.UTC)}, Date(2_816_787.75)},
 	}
 
 	for _, tt := range tests {
@@ -58,6 +62,55 @@ func TestJulian(t *testing.T) {
 	}
 }
 
+func TestTimeExact(t *testing.T) {
+	tests := []struct {
+		name string
+		t    time.Time
+	}{
+		{"J2000", time.Date(2000, time.January, 1, 12, 0, 0, 0, time.UTC)},
+		{"nanoseconds", time.Date(2010, time.February, 14, 5, 21, 7, 123_456_789, time.UTC)},
+		{"year 1000", time.Date(1000, time.March, 1, 0, 0, 0, 1, time.UTC)},
+		{"local", time.Date(2024, time.July, 4, 9, 30, 0, 999_999_999, time.Local)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jd, residual := TimeExact(tt.t)
+			if jd != Time(tt.t) {
+				t.Errorf("TimeExact() = %v, want %v", jd, Time(tt.t))
+			}
+			if residual.Abs() > 50*time.Microsecond {
+				t.Errorf("TimeExact() residual = %v, want under 50µs", residual)
+			}
+			if got := jd.Gregorian().Add(residual); !got.Equal(tt.t) {
+				t.Errorf("Gregorian().Add(residual) = %v, want %v", got, tt.t)
+			}
+		})
+	}
+}
+
+func TestTime_monotonic(t *testing.T) {
+	now := time.Now()
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := Time(now)
+	for _, tm := range []time.Time{now.Round(0), now.UTC(), now.In(tokyo), now.Add(time.Hour).Add(-time.Hour)} {
+		if got := Time(tm); got != want {
+			t.Errorf("Time(%v) = %v, want %v", tm, got, want)
+		}
+	}
+}
+
+func TestNow(t *testing.T) {
+	before := Time(time.Now())
+	got := Now()
+	after := Time(time.Now())
+	if got < before || got > after {
+		t.Errorf("Now() = %f, want between %f and %f", got, before, after)
+	}
+}
+
 func TestJulianDate_Gregorian(t *testing.T) {
 	now := time.Now()
 	layout, _ := time.Parse(time.RFC3339, time.RFC3339)
@@ -68,23 +121,101 @@ func TestJulianDate_Gregorian(t *testing.T) {
 	}{
 		{"now", Time(now), now},
 		{"layout", Time(layout), layout},
+		{"Jan. 1, 1000", Date(2_086_302.5), time.Date(1000, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		{"JD 0", Date(0), time.Date(-4713, time.November, 24, 12, 0, 0, 0, time.UTC)},
+		{"Jan. 1, 3000 6:00", Date(2_816_787.75), time.Date(3000, time.January, 1, 6, 0, 0, 0, time.UTC)},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := tt.jd.Gregorian(); !timeEquals(got, tt.want) {
+			got := tt.jd.Gregorian()
+			if !timeEquals(got, tt.want) {
 				t.Errorf("JulianDate.Gregorian() = %v, want %v", got, tt.want)
 			}
+			if got.Location() != time.Local {
+				t.Errorf("JulianDate.Gregorian() location = %v, want Local", got.Location())
+			}
 		})
 	}
 }
 
+func TestJulianDate_GregorianChecked(t *testing.T) {
+	tests := []struct {
+		name    string
+		jd      Date
+		want    time.Time
+		wantErr bool
+	}{
+		{"J2000", Date(2_451_545), time.Date(2000, time.January, 1, 12, 0, 0, 0, time.UTC), false},
+		{"JD 0", Date(0), time.Date(-4713, time.November, 24, 12, 0, 0, 0, time.UTC), false},
+		{"NaN", Date(math.NaN()), time.Time{}, true},
+		{"infinite", Date(math.Inf(-1)), time.Time{}, true},
+		{"too late", Date(1e17), time.Time{}, true},
+		{"too early", Date(-1e17), time.Time{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.jd.GregorianChecked()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("JulianDate.GregorianChecked() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrRange) {
+				t.Errorf("JulianDate.GregorianChecked() error = %v, want ErrRange", err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("JulianDate.GregorianChecked() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJulianDate_GregorianIn(t *testing.T) {
+	la, err := time.LoadLocation("America/Los_Angeles")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := time.Date(2010, time.February, 14, 5, 21, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		loc  *time.Location
+	}{
+		{"UTC", time.UTC},
+		{"Los Angeles", la},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Time(want).GregorianIn(tt.loc)
+			if got.Location() != tt.loc {
+				t.Errorf("JulianDate.GregorianIn() location = %v, want %v", got.Location(), tt.loc)
+			}
+			if !timeEquals(got, want) {
+				t.Errorf("JulianDate.GregorianIn() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestJulianDate_UTC(t *testing.T) {
+	want := time.Date(2010, time.February, 14, 5, 21, 0, 0, time.UTC)
+	got := Time(want).UTC()
+	if got.Location() != time.UTC {
+		t.Errorf("JulianDate.UTC() location = %v, want UTC", got.Location())
+	}
+	if !timeEquals(got, want) {
+		t.Errorf("JulianDate.UTC() = %v, want %v", got, want)
+	}
+}
+
 func TestJulianDate_Unix(t *testing.T) {
 	tests := []struct {
 		name string
 		jd   Date
 		want int64
 	}{
-		// TODO: Add test cases.
+		{"epoch", Date(julian_unix), 0},
+		{"J2000", Date(2_451_545.0), 946_728_000},
+		{"Feb. 14, 2010 5:21", Time(time.Unix(1_266_124_860, 0)), 1_266_124_860},
+		{"before epoch", Date(julian_unix - 1.5/day_seconds), -2},
+		{"year 1000", Date(2_086_302.5), -30_610_224_000},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -95,6 +226,307 @@ func TestJulianDate_Unix(t *testing.T) {
 	}
 }
 
+func TestJulianDate_UnixNano(t *testing.T) {
+	tests := []struct {
+		name string
+		jd   Date
+		want int64
+	}{
+		{"epoch", Date(julian_unix), 0},
+		{"J2000", Date(2_451_545.0), 946_728_000 * 1e9},
+		{"before epoch", Date(julian_unix - 0.25), -6 * 3600 * 1e9},
+		{"noon", Date(julian_unix + 0.5), 12 * 3600 * 1e9},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.jd.UnixNano(); got != tt.want {
+				t.Errorf("JulianDate.UnixNano() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFromDayNumber(t *testing.T) {
+	tests := []struct {
+		name string
+		jdn  int64
+		frac float64
+		want Date
+	}{
+		{"J2000", 2_451_545, 0, Date(2_451_545.0)},
+		{"evening", 2_451_545, 0.25, Date(2_451_545.25)},
+		{"negative", -1, 0.75, Date(-0.25)},
+		{"carry", 2_451_545, 1.5, Date(2_451_546.5)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FromDayNumber(tt.jdn, tt.frac); got != tt.want {
+				t.Errorf("FromDayNumber() = %f, want %f", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFromJDN(t *testing.T) {
+	for _, jdn := range []int64{0, -1, 2_451_545, 5_373_484} {
+		got := FromJDN(jdn)
+		if got != Date(jdn) {
+			t.Errorf("FromJDN(%d) = %f, want %d", jdn, got, jdn)
+		}
+		if got.JDN() != jdn {
+			t.Errorf("FromJDN(%d).JDN() = %d", jdn, got.JDN())
+		}
+	}
+}
+
+func TestFromUnix(t *testing.T) {
+	tests := []struct {
+		name      string
+		sec, nsec int64
+		want      Date
+	}{
+		{"epoch", 0, 0, Date(julian_unix)},
+		{"J2000", 946_728_000, 0, Date(2_451_545.0)},
+		{"nsec", 946_728_000, 21_600_000_000_000, Date(2_451_545.25)},
+		{"negative nsec", 946_728_000, -43_200_000_000_000, Date(2_451_544.5)},
+		{"before epoch", -86_400 * 365, 0, Date(julian_unix - 365)},
+		{"before 1678", -10_000_000_000, 0, Date(julian_unix) - Date(10_000_000_000)/day_seconds},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FromUnix(tt.sec, tt.nsec); !equalJulian(got, tt.want) {
+				t.Errorf("FromUnix() = %f, want %f", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFromUnixMilli(t *testing.T) {
+	tests := []struct {
+		name string
+		msec int64
+		want Date
+	}{
+		{"epoch", 0, Date(julian_unix)},
+		{"J2000", 946_728_000_000, Date(2_451_545.0)},
+		{"before epoch", -43_200_000, Date(julian_unix - 0.5)},
+		{"Feb. 14, 2010 5:21", time.Date(2010, time.February, 14, 5, 21, 0, 0, time.UTC).UnixMilli(), Date(2_455_241.722917)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FromUnixMilli(tt.msec); !equalJulian(got, tt.want) {
+				t.Errorf("FromUnixMilli() = %f, want %f", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJulianDate_UnixMilli(t *testing.T) {
+	tests := []struct {
+		name string
+		t    time.Time
+	}{
+		{"epoch", time.Unix(0, 0)},
+		{"J2000", time.Date(2000, 1, 1, 12, 0, 0, 0, time.UTC)},
+		{"millis", time.Date(2010, time.February, 14, 5, 21, 0, 250_000_000, time.UTC)},
+		{"before epoch", time.Date(1969, time.July, 20, 20, 17, 40, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jd := Time(tt.t)
+			if got, want := jd.UnixMilli(), tt.t.UnixMilli(); got-want > 1 || want-got > 1 {
+				t.Errorf("JulianDate.UnixMilli() = %v, want %v", got, want)
+			}
+			if got, want := jd.UnixMicro(), tt.t.UnixMicro(); got-want > 50 || want-got > 50 {
+				t.Errorf("JulianDate.UnixMicro() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestJulianDate_IsValid(t *testing.T) {
+	tests := []struct {
+		name  string
+		jd    Date
+		valid bool
+		zero  bool
+	}{
+		{"zero", Date(0), true, true},
+		{"J2000", Date(2_451_545.0), true, false},
+		{"negative", Date(-1), true, false},
+		{"NaN", Date(math.NaN()), false, false},
+		{"+Inf", Date(math.Inf(1)), false, false},
+		{"-Inf", Date(math.Inf(-1)), false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.jd.IsValid(); got != tt.valid {
+				t.Errorf("JulianDate.IsValid() = %v, want %v", got, tt.valid)
+			}
+			if got := tt.jd.IsZero(); got != tt.zero {
+				t.Errorf("JulianDate.IsZero() = %v, want %v", got, tt.zero)
+			}
+		})
+	}
+}
+
+func TestJulianDate_UnixNanoChecked(t *testing.T) {
+	tests := []struct {
+		name    string
+		jd      Date
+		want    int64
+		wantErr bool
+	}{
+		{"epoch", Date(julian_unix), 0, false},
+		{"J2000", Date(2_451_545.0), 946_728_000 * 1e9, false},
+		{"before epoch", Date(julian_unix - 1), -day_nanoseconds, false},
+		{"before 1678", Date(2_000_000.5), 0, true},
+		{"after 2262", Date(2_600_000.5), 0, true},
+		{"NaN", Date(math.NaN()), 0, true},
+		{"Inf", Date(math.Inf(1)), 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.jd.UnixNanoChecked()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("JulianDate.UnixNanoChecked() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && err != ErrRange {
+				t.Errorf("JulianDate.UnixNanoChecked() error = %v, want ErrRange", err)
+			}
+			if got != tt.want {
+				t.Errorf("JulianDate.UnixNanoChecked() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJulianDate_UnixChecked(t *testing.T) {
+	tests := []struct {
+		name    string
+		jd      Date
+		want    int64
+		wantErr bool
+	}{
+		{"J2000", Date(2_451_545.0), 946_728_000, false},
+		{"JD 0", Date(0), -210_866_760_000, false},
+		{"far future", Date(1e12), (1e12 - julian_unix) * day_seconds, false},
+		{"overflow", Date(1e15), 0, true},
+		{"NaN", Date(math.NaN()), 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.jd.UnixChecked()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("JulianDate.UnixChecked() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("JulianDate.UnixChecked() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScaleChecked(t *testing.T) {
+	const perDay = day_nanoseconds
+	tests := []struct {
+		name    string
+		days, q int64
+		want    int64
+		ok      bool
+	}{
+		{"zero", 0, 0, 0, true},
+		{"max", math.MaxInt64 / perDay, math.MaxInt64 % perDay, math.MaxInt64, true},
+		{"above max", math.MaxInt64 / perDay, math.MaxInt64%perDay + 1, 0, false},
+		{"min", math.MinInt64/perDay - 1, perDay + math.MinInt64%perDay, math.MinInt64, true},
+		{"below min", math.MinInt64/perDay - 1, perDay + math.MinInt64%perDay - 1, 0, false},
+		{"far below min", math.MinInt64/perDay - 2, 0, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := scaleChecked(tt.days, perDay, tt.q)
+			if got != tt.want || ok != tt.ok {
+				t.Errorf("scaleChecked() = %v, %v, want %v, %v", got, ok, tt.want, tt.ok)
+			}
+		})
+	}
+}
+
+func TestJulianDate_Split(t *testing.T) {
+	tests := []struct {
+		name string
+		jd   Date
+		day  int64
+		frac float64
+	}{
+		{"J2000", Date(2_451_545.0), 2_451_545, 0},
+		{"evening", Date(2_451_545.25), 2_451_545, 0.25},
+		{"morning", Date(2_451_545.75), 2_451_545, 0.75},
+		{"zero", Date(0), 0, 0},
+		{"negative", Date(-0.25), -1, 0.75},
+		{"negative whole", Date(-2), -2, 0},
+		{"tiny negative", Date(-1e-20), 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			day, frac := tt.jd.Split()
+			if day != tt.day || frac != tt.frac {
+				t.Errorf("JulianDate.Split() = %v, %v, want %v, %v", day, frac, tt.day, tt.frac)
+			}
+			if frac < 0 || frac >= 1 {
+				t.Errorf("JulianDate.Split() fraction %v out of range", frac)
+			}
+		})
+	}
+}
+
+func TestJulianDate_Key(t *testing.T) {
+	t0 := time.Date(2010, time.February, 14, 5, 21, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		jd   Date
+		res  time.Duration
+		want int64
+	}{
+		{"epoch", Date(julian_unix), time.Second, 0},
+		{"seconds", Time(t0), time.Second, t0.Unix()},
+		{"millis", Time(t0), time.Millisecond, t0.UnixMilli()},
+		{"minutes", Time(t0.Add(29 * time.Second)), time.Minute, t0.Unix() / 60},
+		{"round up", Time(t0.Add(31 * time.Second)), time.Minute, t0.Unix()/60 + 1},
+		{"before epoch", Date(julian_unix - 0.5), time.Hour, -12},
+		{"odd resolution", Date(julian_unix + 1), 7 * time.Hour, 3},
+		{"zero resolution", Date(julian_unix + 0.5), 0, day_nanoseconds / 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.jd.Key(tt.res); got != tt.want {
+				t.Errorf("JulianDate.Key() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJulianDate_Quantize(t *testing.T) {
+	t0 := time.Date(2010, time.February, 14, 5, 21, 0, 0, time.UTC)
+	a := Time(t0)
+	b := FromUnixMilli(t0.UnixMilli()) + 1e-9
+	if a == b {
+		t.Fatal("test dates should differ")
+	}
+	for _, res := range []time.Duration{time.Millisecond, time.Second, time.Minute, 7 * time.Second} {
+		qa, qb := a.Quantize(res), b.Quantize(res)
+		if qa != qb {
+			t.Errorf("Quantize(%v) = %f and %f, want equal", res, qa, qb)
+		}
+		if qa.Quantize(res) != qa {
+			t.Errorf("Quantize(%v) is not idempotent: %f, %f", res, qa, qa.Quantize(res))
+		}
+		if !qa.ApproxEqual(a, res/2+time.Microsecond) {
+			t.Errorf("Quantize(%v) = %f, too far from %f", res, qa, a)
+		}
+	}
+}
+
 func TestJulianDate_Time(t *testing.T) {
 	tests := []struct {
 		name string
@@ -106,16 +538,37 @@ func TestJulianDate_Time(t *testing.T) {
 		{"Jan. 1, 1990", Time(time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC)), 0.50000},
 		{"July 4, 1998", Time(time.Date(1998, time.July, 4, 0, 0, 0, 0, time.UTC)), 0.50000},
 		{"Feb. 14, 2010 5:21", Time(time.Date(2010, time.February, 14, 5, 21, 0, 0, time.UTC)), 0.72292},
+		{"negative", Date(-0.25), 0.75},
+		{"negative whole", Date(-3), 0},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := tt.jd.Time(); got-tt.want > 0.00000001 {
+			if got := tt.jd.Time(); got-tt.want > 0.00000001 || got < 0 {
 				t.Errorf("JulianDate.Time() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
 
+func TestJulianDate_Duration(t *testing.T) {
+	tests := []struct {
+		name string
+		jd   Date
+		want time.Duration
+	}{
+		{"noon", Date(2_451_545.0), 0},
+		{"evening", Date(2_451_545.25), 6 * time.Hour},
+		{"negative", Date(-0.25), 18 * time.Hour},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.jd.Duration(); got != tt.want {
+				t.Errorf("JulianDate.Duration() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestJulianDate_Day(t *testing.T) {
 	tests := []struct {
 		name string
@@ -150,6 +603,86 @@ func TestJulianDate_DayNumber(t *testing.T) {
 	}
 }
 
+func TestJulianDate_JDN(t *testing.T) {
+	tests := []struct {
+		name      string
+		jd        Date
+		want      int64
+		truncated int64
+	}{
+		{"J2000", Date(2_451_545.0), 2_451_545, 2_451_545},
+		{"J2000 midnight", Date(2_451_544.5), 2_451_545, 2_451_544},
+		{"before midnight", Date(2_451_544.49), 2_451_544, 2_451_544},
+		{"evening", Date(2_451_545.25), 2_451_545, 2_451_545},
+		{"JD 0", Date(0), 0, 0},
+		{"negative", Date(-0.75), -1, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.jd.JDN(); got != tt.want {
+				t.Errorf("JulianDate.JDN() = %v, want %v", got, tt.want)
+			}
+			if got := tt.jd.TruncatedDay(); got != tt.truncated {
+				t.Errorf("JulianDate.TruncatedDay() = %v, want %v", got, tt.truncated)
+			}
+		})
+	}
+}
+
+func TestJulianDate_CivilDayNumber(t *testing.T) {
+	la, err := time.LoadLocation("America/Los_Angeles")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name string
+		jd   Date
+		loc  *time.Location
+		want int64
+	}{
+		{"UTC", Date(2_451_544.5), time.UTC, 2_451_545},
+		{"LA previous day", Date(2_451_544.5), la, 2_451_544},
+		{"LA evening", Time(time.Date(2000, 1, 1, 23, 0, 0, 0, la)), la, 2_451_545},
+		{"UTC next day", Time(time.Date(2000, 1, 1, 23, 0, 0, 0, la)), time.UTC, 2_451_546},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.jd.CivilDayNumber(tt.loc); got != tt.want {
+				t.Errorf("JulianDate.CivilDayNumber() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDayNumberOfTime(t *testing.T) {
+	tests := []struct {
+		name     string
+		t        time.Time
+		jdn, mjd int64
+	}{
+		{"J2000", time.Date(2000, time.January, 1, 12, 0, 0, 0, time.UTC), 2_451_545, 51_544},
+		{"midnight", time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC), 2_451_545, 51_544},
+		{"last second", time.Date(2000, time.January, 1, 23, 59, 59, 900_000_000, time.UTC), 2_451_545, 51_544},
+		{"unix", time.Unix(0, 0), 2_440_588, 40_587},
+		{"before unix", time.Unix(-1, 0), 2_440_587, 40_586},
+		{"MJD 0", time.Date(1858, time.November, 17, 6, 0, 0, 0, time.UTC), 2_400_001, 0},
+		{"year 1000", time.Date(1000, time.January, 1, 0, 0, 0, 0, time.UTC), 2_086_303, -313_698},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DayNumberOfTime(tt.t); got != tt.jdn {
+				t.Errorf("DayNumberOfTime() = %d, want %d", got, tt.jdn)
+			}
+			if got := Time(tt.t).JDN(); got != tt.jdn {
+				t.Errorf("Time().JDN() = %d, want %d", got, tt.jdn)
+			}
+			if got := MJDOfTime(tt.t); got != tt.mjd {
+				t.Errorf("MJDOfTime() = %d, want %d", got, tt.mjd)
+			}
+		})
+	}
+}
+
 func TestJulianDate_Century(t *testing.T) {
 	tests := []struct {
 		name string
@@ -166,3 +699,459 @@ func TestJulianDate_Century(t *testing.T) {
 		})
 	}
 }
+
+func TestJulianDate_Compare(t *testing.T) {
+	nan := Date(math.NaN())
+	tests := []struct {
+		name  string
+		jd    Date
+		other Date
+		want  int
+	}{
+		{"before", Date(2_451_545.0), Date(2_451_545.5), -1},
+		{"after", Date(2_451_545.5), Date(2_451_545.0), 1},
+		{"equal", Date(2_451_545.0), Date(2_451_545.0), 0},
+		{"NaN before", nan, Date(0), -1},
+		{"NaN after", Date(0), nan, 1},
+		{"NaN equal", nan, nan, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.jd.Compare(tt.other); got != tt.want {
+				t.Errorf("JulianDate.Compare() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJulianDate_BeforeAfterEqual(t *testing.T) {
+	tests := []struct {
+		name                string
+		jd                  Date
+		other               Date
+		before, after, want bool
+	}{
+		{"before", Date(2_451_545.0), Date(2_451_545.5), true, false, false},
+		{"after", Date(2_451_545.5), Date(2_451_545.0), false, true, false},
+		{"equal", Date(2_451_545.0), Date(2_451_545.0), false, false, true},
+		{"NaN", Date(math.NaN()), Date(math.NaN()), false, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.jd.Before(tt.other); got != tt.before {
+				t.Errorf("JulianDate.Before() = %v, want %v", got, tt.before)
+			}
+			if got := tt.jd.After(tt.other); got != tt.after {
+				t.Errorf("JulianDate.After() = %v, want %v", got, tt.after)
+			}
+			if got := tt.jd.Equal(tt.other); got != tt.want {
+				t.Errorf("JulianDate.Equal() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMinMax(t *testing.T) {
+	a, b, c := Date(2_451_545.0), Date(2_451_545.5), Date(2_451_544.0)
+	if got := Min(a, b); got != a {
+		t.Errorf("Min() = %f, want %f", got, a)
+	}
+	if got := Max(a, b); got != b {
+		t.Errorf("Max() = %f, want %f", got, b)
+	}
+	if got := MinOf(a, b, c); got != c {
+		t.Errorf("MinOf() = %f, want %f", got, c)
+	}
+	if got := MaxOf(a, b, c); got != b {
+		t.Errorf("MaxOf() = %f, want %f", got, b)
+	}
+	if got := Min(a, Date(math.NaN())); !math.IsNaN(float64(got)) {
+		t.Errorf("Min() with NaN = %f, want NaN", got)
+	}
+	if got := MaxOf(a, Date(math.NaN()), b); !math.IsNaN(float64(got)) {
+		t.Errorf("MaxOf() with NaN = %f, want NaN", got)
+	}
+}
+
+func TestJulianDate_Clamp(t *testing.T) {
+	lo, hi := Date(2_451_545.0), Date(2_451_546.0)
+	tests := []struct {
+		name string
+		jd   Date
+		want Date
+	}{
+		{"inside", Date(2_451_545.5), Date(2_451_545.5)},
+		{"below", Date(2_451_544.0), lo},
+		{"above", Date(2_451_547.0), hi},
+		{"at lo", lo, lo},
+		{"at hi", hi, hi},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.jd.Clamp(lo, hi); got != tt.want {
+				t.Errorf("JulianDate.Clamp() = %f, want %f", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJulianDate_ApproxEqual(t *testing.T) {
+	jd := Time(time.Date(2010, time.February, 14, 5, 21, 0, 0, time.UTC))
+	tests := []struct {
+		name  string
+		other Date
+		tol   time.Duration
+		want  bool
+	}{
+		{"same", jd, 0, true},
+		{"within", Time(time.Date(2010, time.February, 14, 5, 21, 0, 400_000_000, time.UTC)), time.Second, true},
+		{"earlier", Time(time.Date(2010, time.February, 14, 5, 20, 59, 600_000_000, time.UTC)), time.Second, true},
+		{"outside", Time(time.Date(2010, time.February, 14, 5, 21, 2, 0, time.UTC)), time.Second, false},
+		{"float noise", jd + 1e-10, time.Millisecond, true},
+		{"NaN", Date(math.NaN()), time.Hour, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := jd.ApproxEqual(tt.other, tt.tol); got != tt.want {
+				t.Errorf("JulianDate.ApproxEqual() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJulianDate_Millennium(t *testing.T) {
+	tests := []struct {
+		name string
+		jd   Date
+		want float64
+	}{
+		{"J2000", Date(2_451_545.0), 0},
+		{"J3000", Date(2_451_545.0 + 365_250), 1},
+		{"J1500", Date(2_451_545.0 - 182_625), -0.5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.jd.Millennium(); got != tt.want {
+				t.Errorf("JulianDate.Millennium() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJulianDate_DaysSinceJ2000(t *testing.T) {
+	tests := []struct {
+		name string
+		jd   Date
+		want float64
+	}{
+		{"J2000", Date(2_451_545.0), 0},
+		{"after", Date(2_451_546.25), 1.25},
+		{"before", Date(2_451_544.5), -0.5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.jd.DaysSinceJ2000(); got != tt.want {
+				t.Errorf("JulianDate.DaysSinceJ2000() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJulianDate_JulianEpoch(t *testing.T) {
+	tests := []struct {
+		name string
+		jd   Date
+		want float64
+	}{
+		{"J2000", Date(2_451_545.0), 2000},
+		{"J2015.5", Date(2_457_206.375), 2015.5},
+		{"J1900", Date(2_415_020.0), 1900},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.jd.JulianEpoch(); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("JulianDate.JulianEpoch() = %v, want %v", got, tt.want)
+			}
+			if got := FromJulianEpoch(tt.want); !equalJulian(got, tt.jd) {
+				t.Errorf("FromJulianEpoch() = %f, want %f", got, tt.jd)
+			}
+		})
+	}
+}
+
+func TestJulianDate_BesselianEpoch(t *testing.T) {
+	tests := []struct {
+		name string
+		jd   Date
+		want float64
+	}{
+		{"B1900", Date(2_415_020.31352), 1900},
+		{"B1950", Date(2_433_282.4235), 1950},
+		{"J2000", Date(2_451_545.0), 2000.0012775},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.jd.BesselianEpoch(); math.Abs(got-tt.want) > 1e-6 {
+				t.Errorf("JulianDate.BesselianEpoch() = %v, want %v", got, tt.want)
+			}
+			if got := FromBesselianEpoch(tt.want); math.Abs(float64(got-tt.jd)) > 1e-3 {
+				t.Errorf("FromBesselianEpoch() = %f, want %f", got, tt.jd)
+			}
+		})
+	}
+}
+
+func TestJulianDate_Add(t *testing.T) {
+	tests := []struct {
+		name string
+		jd   Date
+		d    time.Duration
+		want Date
+	}{
+		{"zero", Date(2_451_545.0), 0, Date(2_451_545.0)},
+		{"hours", Date(2_451_545.0), 6 * time.Hour, Date(2_451_545.25)},
+		{"negative", Date(2_451_545.0), -36 * time.Hour, Date(2_451_543.5)},
+		{"seconds", Date(2_455_241.5), 5*time.Hour + 21*time.Minute, Date(2_455_241.722917)},
+		{"before 1678", Date(2_000_000.5), 24 * time.Hour, Date(2_000_001.5)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.jd.Add(tt.d); !equalJulian(got, tt.want) {
+				t.Errorf("JulianDate.Add() = %f, want %f", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJulianDate_AddDays(t *testing.T) {
+	tests := []struct {
+		name string
+		jd   Date
+		days float64
+		want Date
+	}{
+		{"zero", Date(2_451_545.0), 0, Date(2_451_545.0)},
+		{"whole", Date(2_451_545.0), 10, Date(2_451_555.0)},
+		{"fraction", Date(2_451_545.0), 0.25, Date(2_451_545.25)},
+		{"negative", Date(2_451_545.0), -1.5, Date(2_451_543.5)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.jd.AddDays(tt.days); !equalJulian(got, tt.want) {
+				t.Errorf("JulianDate.AddDays() = %f, want %f", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDays(t *testing.T) {
+	tests := []struct {
+		name string
+		n    float64
+		want time.Duration
+	}{
+		{"zero", 0, 0},
+		{"one", 1, 24 * time.Hour},
+		{"quarter", 0.25, 6 * time.Hour},
+		{"negative", -1.5, -36 * time.Hour},
+		{"max", 1e6, math.MaxInt64},
+		{"min", -1e6, math.MinInt64},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Days(tt.n); got != tt.want {
+				t.Errorf("Days() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJulianDate_AddDate(t *testing.T) {
+	type args struct {
+		years, months, days int
+	}
+	tests := []struct {
+		name string
+		jd   Date
+		args args
+		want Date
+	}{
+		{"zero", Time(time.Date(2011, 1, 1, 0, 0, 0, 0, time.UTC)), args{0, 0, 0}, Time(time.Date(2011, 1, 1, 0, 0, 0, 0, time.UTC))},
+		{"doc example", Time(time.Date(2011, 1, 1, 0, 0, 0, 0, time.UTC)), args{-1, 2, 3}, Time(time.Date(2010, 3, 4, 0, 0, 0, 0, time.UTC))},
+		{"next month", Time(time.Date(2020, 1, 15, 18, 30, 0, 0, time.UTC)), args{0, 1, 0}, Time(time.Date(2020, 2, 15, 18, 30, 0, 0, time.UTC))},
+		{"normalized", Time(time.Date(2020, 10, 31, 6, 0, 0, 0, time.UTC)), args{0, 1, 0}, Time(time.Date(2020, 12, 1, 6, 0, 0, 0, time.UTC))},
+		{"leap day", Time(time.Date(2020, 2, 29, 12, 0, 0, 0, time.UTC)), args{1, 0, 0}, Time(time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC))},
+		{"days", Time(time.Date(2020, 12, 31, 23, 0, 0, 0, time.UTC)), args{0, 0, 1}, Time(time.Date(2021, 1, 1, 23, 0, 0, 0, time.UTC))},
+		{"negative months", Time(time.Date(2020, 1, 15, 0, 0, 0, 0, time.UTC)), args{0, -13, 0}, Time(time.Date(2018, 12, 15, 0, 0, 0, 0, time.UTC))},
+		{"BC", Date(0), args{1, 2, 0}, Date(365 + 31 + 31)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.jd.AddDate(tt.args.years, tt.args.months, tt.args.days); !equalJulian(got, tt.want) {
+				t.Errorf("JulianDate.AddDate() = %f, want %f", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJulianDate_Sub(t *testing.T) {
+	tests := []struct {
+		name  string
+		jd    Date
+		other Date
+		want  time.Duration
+	}{
+		{"zero", Date(2_451_545.0), Date(2_451_545.0), 0},
+		{"day", Date(2_451_546.0), Date(2_451_545.0), 24 * time.Hour},
+		{"negative", Date(2_451_545.0), Date(2_451_545.25), -6 * time.Hour},
+		{"overflow", Date(2_451_545.0), Date(0), math.MaxInt64},
+		{"underflow", Date(0), Date(2_451_545.0), math.MinInt64},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.jd.Sub(tt.other); got != tt.want {
+				t.Errorf("JulianDate.Sub() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJulianDate_Sub_nearby(t *testing.T) {
+	t0 := time.Date(2010, time.February, 14, 5, 21, 0, 0, time.UTC)
+	t1 := t0.Add(90 * time.Second)
+	got := Time(t1).Sub(Time(t0))
+	if diff := got - 90*time.Second; diff < -50*time.Microsecond || diff > 50*time.Microsecond {
+		t.Errorf("JulianDate.Sub() = %v, want %v", got, 90*time.Second)
+	}
+}
+
+func TestJulianDate_SubDays(t *testing.T) {
+	tests := []struct {
+		name  string
+		jd    Date
+		other Date
+		want  float64
+	}{
+		{"zero", Date(2_451_545.0), Date(2_451_545.0), 0},
+		{"fraction", Date(2_451_546.25), Date(2_451_545.0), 1.25},
+		{"negative", Date(2_451_545.0), Date(2_451_545.5), -0.5},
+		{"long", Date(2_451_545.0), Date(0), 2_451_545.0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.jd.SubDays(tt.other); got != tt.want {
+				t.Errorf("JulianDate.SubDays() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJulianDate_Truncate(t *testing.T) {
+	t0 := time.Date(2010, time.February, 14, 5, 21, 37, 600_000_000, time.UTC)
+	tests := []struct {
+		name string
+		d    time.Duration
+		want time.Time
+	}{
+		{"zero", 0, t0},
+		{"second", time.Second, time.Date(2010, time.February, 14, 5, 21, 37, 0, time.UTC)},
+		{"minute", time.Minute, time.Date(2010, time.February, 14, 5, 21, 0, 0, time.UTC)},
+		{"hour", time.Hour, time.Date(2010, time.February, 14, 5, 0, 0, 0, time.UTC)},
+		{"day", 24 * time.Hour, time.Date(2010, time.February, 14, 0, 0, 0, 0, time.UTC)},
+		{"week", 7 * 24 * time.Hour, t0.Truncate(7 * 24 * time.Hour)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Time(t0).Truncate(tt.d); !equalJulian(got, Time(tt.want)) {
+				t.Errorf("JulianDate.Truncate() = %v, want %v", got.Gregorian().UTC(), tt.want)
+			}
+		})
+	}
+}
+
+func TestJulianDate_Round(t *testing.T) {
+	t0 := time.Date(2010, time.February, 14, 17, 31, 40, 0, time.UTC)
+	tests := []struct {
+		name string
+		d    time.Duration
+		want time.Time
+	}{
+		{"zero", 0, t0},
+		{"second", time.Second, t0},
+		{"minute", time.Minute, time.Date(2010, time.February, 14, 17, 32, 0, 0, time.UTC)},
+		{"hour", time.Hour, time.Date(2010, time.February, 14, 18, 0, 0, 0, time.UTC)},
+		{"day", 24 * time.Hour, time.Date(2010, time.February, 15, 0, 0, 0, 0, time.UTC)},
+		{"week", 7 * 24 * time.Hour, t0.Round(7 * 24 * time.Hour)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Time(t0).Round(tt.d); !equalJulian(got, Time(tt.want)) {
+				t.Errorf("JulianDate.Round() = %v, want %v", got.Gregorian().UTC(), tt.want)
+			}
+		})
+	}
+}
+
+func TestJulianDate_StartOfJulianDay(t *testing.T) {
+	tests := []struct {
+		name string
+		jd   Date
+		want Date
+	}{
+		{"noon", Date(2_451_545.0), Date(2_451_545.0)},
+		{"evening", Date(2_451_545.25), Date(2_451_545.0)},
+		{"morning", Date(2_451_545.75), Date(2_451_545.0)},
+		{"negative", Date(-0.25), Date(-1)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.jd.StartOfJulianDay(); got != tt.want {
+				t.Errorf("JulianDate.StartOfJulianDay() = %f, want %f", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJulianDate_NoonUTC(t *testing.T) {
+	tests := []struct {
+		name string
+		jd   Date
+		want Date
+	}{
+		{"noon", Date(2_451_545.0), Date(2_451_545.0)},
+		{"evening", Date(2_451_545.25), Date(2_451_545.0)},
+		{"after midnight", Date(2_451_545.5), Date(2_451_546.0)},
+		{"morning", Date(2_451_545.4), Date(2_451_545.0)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.jd.NoonUTC(); got != tt.want {
+				t.Errorf("JulianDate.NoonUTC() = %f, want %f", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJulianDate_StartOfCivilDay(t *testing.T) {
+	la, err := time.LoadLocation("America/Los_Angeles")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name string
+		jd   Date
+		loc  *time.Location
+		want Date
+	}{
+		{"UTC", Time(time.Date(2010, time.February, 14, 5, 21, 0, 0, time.UTC)), time.UTC, Time(time.Date(2010, time.February, 14, 0, 0, 0, 0, time.UTC))},
+		{"LA previous day", Time(time.Date(2010, time.February, 14, 5, 21, 0, 0, time.UTC)), la, Time(time.Date(2010, time.February, 13, 0, 0, 0, 0, la))},
+		{"LA same day", Time(time.Date(2010, time.February, 14, 20, 0, 0, 0, time.UTC)), la, Time(time.Date(2010, time.February, 14, 0, 0, 0, 0, la))},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.jd.StartOfCivilDay(tt.loc); !equalJulian(got, tt.want) {
+				t.Errorf("JulianDate.StartOfCivilDay() = %f, want %f", got, tt.want)
+			}
+		})
+	}
+}
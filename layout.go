@@ -0,0 +1,247 @@
+package julian
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Layout tokens understood by FormatLayout and ParseFormat.
+const (
+	tok_none = iota
+	tok_jd
+	tok_mjd
+	tok_day
+	tok_frac
+	tok_time
+)
+
+type layoutToken struct {
+	kind   int
+	digits int
+	layout string
+}
+
+// nextToken splits layout into the literal text before the first token, the
+// token itself and the remainder of the layout.
+func nextToken(layout string) (prefix string, tok layoutToken, rest string) {
+	for i := 0; i < len(layout); i++ {
+		if layout[i] != '{' {
+			continue
+		}
+		if i+1 < len(layout) && layout[i+1] == '{' {
+			return layout[:i+1], layoutToken{}, layout[i+2:]
+		}
+		end := strings.IndexByte(layout[i:], '}')
+		if end < 0 {
+			break
+		}
+		if tok, ok := parseToken(layout[i+1 : i+end]); ok {
+			return layout[:i], tok, layout[i+end+1:]
+		}
+	}
+	return layout, layoutToken{}, ""
+}
+
+func parseToken(s string) (layoutToken, bool) {
+	if l, ok := strings.CutPrefix(s, "t:"); ok {
+		return layoutToken{kind: tok_time, layout: l}, true
+	}
+	name, prec, hasPrec := strings.Cut(s, ".")
+	tok := layoutToken{digits: default_digits}
+	switch name {
+	case "jd":
+		tok.kind = tok_jd
+	case "mjd":
+		tok.kind = tok_mjd
+	case "frac":
+		tok.kind = tok_frac
+	case "day":
+		if hasPrec {
+			return tok, false
+		}
+		tok.kind = tok_day
+	default:
+		return tok, false
+	}
+	if hasPrec {
+		n, err := strconv.Atoi(prec)
+		if err != nil || n < 0 {
+			return tok, false
+		}
+		tok.digits = n
+	}
+	return tok, true
+}
+
+// FormatLayout returns a textual representation of the julian date formatted
+// according to layout. A token in the layout is enclosed in braces; any other
+// text is copied literally, and "{{" stands for a literal brace.
+//
+//	{jd}        julian date, e.g. 2451545.50000
+//	{mjd}       modified julian date, e.g. 51545.00000
+//	{day}       integer part of the julian date, floor(jd), e.g. 2451545
+//	{frac}      fraction of the julian day, e.g. 0.50000
+//	{t:layout}  the UTC time formatted with a time package layout
+//
+// The {day} token is the integer part that {frac} completes, not the julian
+// day number of JDN and the %d verb, which numbers the UTC calendar day: from
+// midnight to noon UTC {day} is one less.
+//
+// The jd, mjd and frac tokens take an optional number of fractional digits
+// as in {jd.8}; the default is 5. For example, the layout
+// "JD {jd.3} ({t:2006-01-02T15:04:05Z})" formats J2000 as
+// "JD 2451545.000 (2000-01-01T12:00:00Z)". The {day} token carries the
+// rounding of {frac}, so "{day} {frac.3}" formats 2451545.9999996 as
+// "2451546 0.000".
+func (jd Date) FormatLayout(layout string) string {
+	return string(jd.AppendFormat(make([]byte, 0, len(layout)+16), layout))
+}
+
+// AppendFormat is like FormatLayout but appends the textual representation
+// to b and returns the extended buffer. It does not allocate when b has
+// enough capacity.
+func (jd Date) AppendFormat(b []byte, layout string) []byte {
+	day, frac := jd.splitDay(layout)
+	for layout != "" {
+		prefix, tok, rest := nextToken(layout)
+		b = append(b, prefix...)
+		switch tok.kind {
+		case tok_jd:
+			b = strconv.AppendFloat(b, float64(jd), 'f', tok.digits, 64)
+		case tok_mjd:
+			b = strconv.AppendFloat(b, float64(jd-mjd_offset), 'f', tok.digits, 64)
+		case tok_day:
+			b = strconv.AppendInt(b, int64(day), 10)
+		case tok_frac:
+			b = strconv.AppendFloat(b, frac, 'f', tok.digits, 64)
+		case tok_time:
+			b = jd.UTC().AppendFormat(b, tok.layout)
+		}
+		layout = rest
+	}
+	return b
+}
+
+// splitDay returns the integer julian day and the fraction of the day of jd
+// for the {day} and {frac} tokens. The fraction is rounded to the digits of
+// the first {frac} token in layout, carrying into the day, so that the two
+// never print as a fraction of 1.
+func (jd Date) splitDay(layout string) (day, frac float64) {
+	day = math.Floor(float64(jd))
+	frac = float64(jd) - day
+	for layout != "" {
+		_, tok, rest := nextToken(layout)
+		if tok.kind == tok_frac {
+			p := math.Pow10(tok.digits)
+			if frac = math.Round(frac*p) / p; frac >= 1 {
+				day, frac = day+1, 0
+			}
+			break
+		}
+		layout = rest
+	}
+	return day, frac
+}
+
+// ParseFormat parses a julian date formatted according to layout, as produced
+// by FormatLayout.
+//
+// The value is taken from the first of the {jd} or {mjd} tokens, a {t:...}
+// token, or a {day} token combined with an optional {frac} token that appears
+// in the layout.
+func ParseFormat(layout, value string) (Date, error) {
+	var (
+		jd, frac         float64
+		day              int64
+		t                time.Time
+		hasJD, hasDay    bool
+		hasFrac, hasTime bool
+		element          string
+	)
+	original := value
+	for layout != "" {
+		prefix, tok, rest := nextToken(layout)
+		if !strings.HasPrefix(value, prefix) {
+			return 0, parseFormatError(original, prefix)
+		}
+		value = value[len(prefix):]
+		layout = rest
+		if tok.kind == tok_none {
+			continue
+		}
+		if tok.kind == tok_time {
+			element, value = cutTime(value, layout)
+		} else {
+			element, value = cutNumber(value, tok.kind != tok_day)
+		}
+		var err error
+		switch tok.kind {
+		case tok_jd, tok_mjd:
+			var f float64
+			if f, err = strconv.ParseFloat(element, 64); err == nil && !hasJD {
+				if tok.kind == tok_mjd {
+					f += mjd_offset
+				}
+				jd, hasJD = f, true
+			}
+		case tok_day:
+			day, err = strconv.ParseInt(element, 10, 64)
+			hasDay = true
+		case tok_frac:
+			frac, err = strconv.ParseFloat(element, 64)
+			hasFrac = true
+		case tok_time:
+			t, err = time.Parse(tok.layout, element)
+			hasTime = true
+		}
+		if err != nil {
+			return 0, parseFormatError(original, element)
+		}
+	}
+	if value != "" {
+		return 0, parseFormatError(original, value)
+	}
+	switch {
+	case hasJD:
+		return Date(jd), nil
+	case hasTime:
+		return Time(t), nil
+	case hasDay:
+		return Date(float64(day) + frac), nil
+	case hasFrac:
+		return 0, fmt.Errorf("%w: %q: fraction without day", ErrSyntax, original)
+	}
+	return 0, fmt.Errorf("%w: %q: layout has no date", ErrSyntax, original)
+}
+
+func parseFormatError(value, element string) error {
+	return fmt.Errorf("%w: %q: cannot parse %q", ErrSyntax, value, element)
+}
+
+// cutNumber splits a leading decimal number from s.
+func cutNumber(s string, fraction bool) (num, rest string) {
+	i := 0
+	if i < len(s) && (s[i] == '+' || s[i] == '-') {
+		i++
+	}
+	for i < len(s) && ('0' <= s[i] && s[i] <= '9' || fraction && s[i] == '.') {
+		i++
+	}
+	return s[:i], s[i:]
+}
+
+// cutTime splits the text matching a time layout from s. The time extends to
+// the literal text that follows it in layout, or to the end of s.
+func cutTime(s, layout string) (elem, rest string) {
+	prefix, _, _ := nextToken(layout)
+	if prefix == "" {
+		return s, ""
+	}
+	if i := strings.Index(s, prefix); i >= 0 {
+		return s[:i], s[i:]
+	}
+	return s, ""
+}
@@ -0,0 +1,125 @@
+package julian
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestJulianDate_FormatLayout(t *testing.T) {
+	tests := []struct {
+		name   string
+		jd     Date
+		layout string
+		want   string
+	}{
+		{"jd", Date(2_451_545.0), "{jd}", "2451545.00000"},
+		{"jd digits", Date(2_451_545.0), "JD {jd.3}", "JD 2451545.000"},
+		{"mjd", Date(2_451_545.0), "MJD {mjd.1}", "MJD 51544.5"},
+		{"day frac", Date(2_451_545.25), "{day} + {frac.2}", "2451545 + 0.25"},
+		{"negative day", Date(-1.25), "{day} {frac.2}", "-2 0.75"},
+		{"frac carry", Date(2_451_545.9999996), "{day} {frac.3}", "2451546 0.000"},
+		{"no carry", Date(2_451_545.9994), "{day} {frac.3}", "2451545 0.999"},
+		{"negative carry", Date(-0.0000004), "{day} {frac.3}", "0 0.000"},
+		{"time", Date(2_451_545.0), "{t:2006-01-02T15:04:05Z}", "2000-01-01T12:00:00Z"},
+		{"mixed", Date(2_451_545.0), "JD {jd.3} ({t:2006-01-02T15:04:05Z})", "JD 2451545.000 (2000-01-01T12:00:00Z)"},
+		{"escaped brace", Date(2_451_545.0), "{{jd} {day}", "{jd} 2451545"},
+		{"unknown token", Date(2_451_545.0), "{foo} {day}", "{foo} 2451545"},
+		{"unterminated", Date(2_451_545.0), "{day} {jd", "2451545 {jd"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.jd.FormatLayout(tt.layout); got != tt.want {
+				t.Errorf("JulianDate.FormatLayout(%q) = %q, want %q", tt.layout, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJulianDate_FormatLayout_dayIsNotJDN(t *testing.T) {
+	for _, tt := range []struct {
+		jd       Date
+		day, jdn string
+	}{
+		{Date(2_455_241.25), "2455241", "2455241"},
+		{Date(2_455_241.72), "2455241", "2455242"},
+	} {
+		if got := tt.jd.FormatLayout("{day}"); got != tt.day {
+			t.Errorf("JulianDate.FormatLayout(%q) = %q, want %q", "{day}", got, tt.day)
+		}
+		if got := fmt.Sprintf("%d", tt.jd); got != tt.jdn {
+			t.Errorf("Sprintf(%q) = %q, want %q", "%d", got, tt.jdn)
+		}
+	}
+}
+
+func TestJulianDate_AppendFormat(t *testing.T) {
+	jd := Date(2_451_545.25)
+	layout := "JD {jd.3} MJD {mjd.2} {t:2006-01-02T15:04:05Z}"
+	b := jd.AppendFormat([]byte("date: "), layout)
+	if want := "date: JD 2451545.250 MJD 51544.75 2000-01-01T18:00:00Z"; string(b) != want {
+		t.Errorf("JulianDate.AppendFormat() = %q, want %q", b, want)
+	}
+	buf := make([]byte, 0, 64)
+	if n := testing.AllocsPerRun(100, func() { jd.AppendFormat(buf[:0], layout) }); n != 0 {
+		t.Errorf("JulianDate.AppendFormat() allocated %v times, want 0", n)
+	}
+}
+
+func TestParseFormat_fracCarry(t *testing.T) {
+	const layout = "{day} {frac.3}"
+	jd := Date(2_451_545.9999996)
+	got, err := ParseFormat(layout, jd.FormatLayout(layout))
+	if err != nil || got != 2_451_546 {
+		t.Errorf("ParseFormat(FormatLayout(%v)) = %v, %v, want JD 2451546", jd, got, err)
+	}
+}
+
+func TestParseFormat(t *testing.T) {
+	tests := []struct {
+		name    string
+		layout  string
+		value   string
+		want    Date
+		wantErr bool
+	}{
+		{"jd", "JD {jd}", "JD 2451545.25", Date(2_451_545.25), false},
+		{"mjd", "MJD={mjd}", "MJD=51544.5", Date(2_451_545.0), false},
+		{"day frac", "{day} + {frac}", "2451545 + 0.25", Date(2_451_545.25), false},
+		{"day", "day {day}", "day 2451545", Date(2_451_545.0), false},
+		{"time", "DATE-OBS= '{t:2006-01-02T15:04:05}'", "DATE-OBS= '2000-01-01T12:00:00'", Date(2_451_545.0), false},
+		{"jd wins", "{jd} {t:2006-01-02}", "2451545.25 2000-01-01", Date(2_451_545.25), false},
+		{"literal mismatch", "JD {jd}", "MJD 51544.5", 0, true},
+		{"trailing text", "{jd}", "2451545.0 TT", 0, true},
+		{"bad number", "{jd}", "x", 0, true},
+		{"bad time", "{t:2006-01-02}", "2000-13-01", 0, true},
+		{"frac only", "{frac}", "0.5", 0, true},
+		{"no date", "JD", "JD", 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseFormat(tt.layout, tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseFormat(%q, %q) error = %v, wantErr %v", tt.layout, tt.value, err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrSyntax) {
+				t.Errorf("ParseFormat(%q, %q) error = %v, want ErrSyntax", tt.layout, tt.value, err)
+			}
+			if !equalJulian(got, tt.want) {
+				t.Errorf("ParseFormat(%q, %q) = %f, want %f", tt.layout, tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseFormat_FormatLayout(t *testing.T) {
+	jd := Date(2_455_241.72291667)
+	layout := "JD {jd.8} {t:2006-01-02}"
+	got, err := ParseFormat(layout, jd.FormatLayout(layout))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != jd {
+		t.Errorf("ParseFormat(FormatLayout()) = %f, want %f", got, jd)
+	}
+}
@@ -0,0 +1,173 @@
+package julian
+
+import (
+	"bytes"
+	_ "embed"
+	"math"
+	"slices"
+	"sort"
+	"sync"
+	"sync/atomic"
+)
+
+// A LeapSecond gives TAI-UTC from the UTC julian date Start onward. Before
+// 1972 UTC ran at a different rate from TAI and the offset drifts as
+// Offset + (MJD - DriftBase) * DriftRate seconds; later entries have no
+// drift.
+type LeapSecond struct {
+	Start     Date
+	Offset    float64 // seconds
+	DriftBase float64 // MJD origin of the drift term
+	DriftRate float64 // seconds per day
+}
+
+// A LeapTable is a table of TAI-UTC offsets in increasing order of Start.
+type LeapTable struct {
+	Entries []LeapSecond
+	Version string // the source of the table, e.g. "IERS Bulletin C 70"
+	Updated Date   // when the table was last updated, or 0 if unknown
+	Expires Date   // when the table stops being valid, or 0 if unknown
+}
+
+// leap_seconds_list is the NIST leap-seconds.list file shipped with the
+// package. Update it, and the tests, when the IERS announces a leap second.
+//
+//go:embed data/leap-seconds.list
+var leap_seconds_list []byte
+
+// embeddedLeapTable returns the parsed embedded table.
+var embeddedLeapTable = sync.OnceValue(func() *LeapTable {
+	t, err := ParseLeapSecondsList(bytes.NewReader(leap_seconds_list))
+	if err != nil {
+		panic("julian: bad embedded leap-seconds.list: " + err.Error())
+	}
+	return t
+})
+
+// LeapSeconds returns the leap second table embedded in the package. The
+// result is a copy that the caller may modify.
+func LeapSeconds() *LeapTable {
+	t := *embeddedLeapTable()
+	t.Entries = slices.Clone(t.Entries)
+	return &t
+}
+
+// activeLeapTable is the table set by SetLeapSeconds, if any.
+var activeLeapTable atomic.Pointer[LeapTable]
+
+// SetLeapSeconds makes t the leap second table used by TAIMinusUTCAt and the
+// time scale conversions, in place of the embedded table. A nil t restores
+// the embedded table. The table must not be modified after the call.
+// SetLeapSeconds is safe for concurrent use.
+func SetLeapSeconds(t *LeapTable) {
+	activeLeapTable.Store(t)
+}
+
+// currentLeapTable returns the table set by SetLeapSeconds or the embedded
+// table.
+func currentLeapTable() *LeapTable {
+	if t := activeLeapTable.Load(); t != nil {
+		return t
+	}
+	return embeddedLeapTable()
+}
+
+// TAIMinusUTCAt returns TAI-UTC in seconds at the UTC julian date jd, from the
+// current leap second table and, for dates before that table begins, the
+// rate offsets of the early UTC since 1961. It is 0 before 1961, when UTC was
+// first defined.
+func TAIMinusUTCAt(jd Date) float64 {
+	entries := currentLeapTable().Entries
+	if len(entries) == 0 || jd < entries[0].Start {
+		return offsetAt(earlyUTC, jd)
+	}
+	return offsetAt(entries, jd)
+}
+
+// TAIMinusUTC returns TAI-UTC in whole seconds at the UTC julian date jd from
+// the current leap second table. It returns ok false before 1972, when the
+// offset was not a whole number of seconds, and from the expiration date of
+// the table on, when a leap second may have been announced since.
+func TAIMinusUTC(jd Date) (seconds int, ok bool) {
+	t := currentLeapTable()
+	if jd < utc_1972 || t.Expired(jd) || len(t.Entries) == 0 || jd < t.Entries[0].Start {
+		return 0, false
+	}
+	return int(math.Round(offsetAt(t.Entries, jd))), true
+}
+
+// NextLeapSecondAfter returns the first leap second in the current leap
+// second table after the UTC julian date jd, as the julian date of the
+// midnight at which the new offset takes effect. It returns false if the
+// table has no later leap second.
+func NextLeapSecondAfter(jd Date) (Date, bool) {
+	entries := currentLeapTable().Entries
+	i := sort.Search(len(entries), func(i int) bool { return entries[i].Start > jd })
+	for ; i < len(entries); i++ {
+		// the step to whole seconds at the start of 1972 is not a leap second
+		if entries[i].Start > utc_1972 && entries[i].DriftRate == 0 {
+			return entries[i].Start, true
+		}
+	}
+	return 0, false
+}
+
+// TAIMinusUTCAt returns TAI-UTC in seconds at the UTC julian date jd from the
+// entries of t alone. It is 0 before the first entry.
+func (t *LeapTable) TAIMinusUTCAt(jd Date) float64 {
+	return offsetAt(t.Entries, jd)
+}
+
+// Expired reports whether the table has an expiration date and it is not
+// after now. An expired table may be missing leap seconds announced since
+// it was published.
+func (t *LeapTable) Expired(now Date) bool {
+	return t.Expires != 0 && now >= t.Expires
+}
+
+// UTCToTAI is like the package function UTCToTAI but uses the entries of t.
+func (t *LeapTable) UTCToTAI(utc Date) Date {
+	return addSeconds(utc, t.TAIMinusUTCAt(utc))
+}
+
+// TAIToUTC is like the package function TAIToUTC but uses the entries of t.
+func (t *LeapTable) TAIToUTC(tai Date) Date {
+	return taiToUTC(tai, t.TAIMinusUTCAt)
+}
+
+// taiMinusUTC is the offset used by the time scale conversions.
+func taiMinusUTC(jd Date) float64 {
+	return TAIMinusUTCAt(jd)
+}
+
+// offsetAt returns TAI-UTC at jd from the sorted entries, or 0 before the
+// first.
+func offsetAt(entries []LeapSecond, jd Date) float64 {
+	i := sort.Search(len(entries), func(i int) bool { return entries[i].Start > jd })
+	if i == 0 {
+		return 0
+	}
+	e := entries[i-1]
+	return e.Offset + (float64(jd-mjd_offset)-e.DriftBase)*e.DriftRate
+}
+
+// utc_1972 is the julian date of January 1, 1972, when UTC adopted whole leap
+// seconds.
+const utc_1972 = 2441317.5
+
+// earlyUTC is TAI-UTC before 1972 from the USNO tai-utc.dat table.
+var earlyUTC = []LeapSecond{
+	{2_437_300.5, 1.4228180, 37300, 0.001296},
+	{2_437_512.5, 1.3728180, 37300, 0.001296},
+	{2_437_665.5, 1.8458580, 37665, 0.0011232},
+	{2_438_334.5, 1.9458580, 37665, 0.0011232},
+	{2_438_395.5, 3.2401300, 38761, 0.001296},
+	{2_438_486.5, 3.3401300, 38761, 0.001296},
+	{2_438_639.5, 3.4401300, 38761, 0.001296},
+	{2_438_761.5, 3.5401300, 38761, 0.001296},
+	{2_438_820.5, 3.6401300, 38761, 0.001296},
+	{2_438_942.5, 3.7401300, 38761, 0.001296},
+	{2_439_004.5, 3.8401300, 38761, 0.001296},
+	{2_439_126.5, 4.3131700, 39126, 0.002592},
+	{2_439_887.5, 4.2131700, 39126, 0.002592},
+}
@@ -0,0 +1,150 @@
+package julian
+
+import (
+	"math"
+	"testing"
+)
+
+func TestLeapSeconds(t *testing.T) {
+	table := LeapSeconds()
+	if len(table.Entries) != 28 {
+		t.Fatalf("LeapSeconds() has %d entries, want 28", len(table.Entries))
+	}
+	first, last := table.Entries[0], table.Entries[len(table.Entries)-1]
+	if first != (LeapSecond{Start: 2_441_317.5, Offset: 10}) {
+		t.Errorf("LeapSeconds() first entry = %+v, want 1972 JAN 1 10s", first)
+	}
+	if last != (LeapSecond{Start: 2_457_754.5, Offset: 37}) {
+		t.Errorf("LeapSeconds() last entry = %+v, want 2017 JAN 1 37s", last)
+	}
+	if table.Version != "IERS Bulletin C 72" {
+		t.Errorf("LeapSeconds().Version = %q", table.Version)
+	}
+	if y, m, d := table.Expires.Date(); y != 2027 || m != 6 || d != 28 {
+		t.Errorf("LeapSeconds().Expires = %d-%d-%d, want 2027-06-28", y, m, d)
+	}
+	if !table.Updated.Before(table.Expires) {
+		t.Errorf("LeapSeconds().Updated = %v, after Expires %v", table.Updated, table.Expires)
+	}
+
+	// the copy is the caller's own
+	table.Entries[0].Offset = 99
+	if LeapSeconds().Entries[0].Offset != 10 {
+		t.Errorf("LeapSeconds() shares its entries")
+	}
+}
+
+func TestTAIMinusUTCAt(t *testing.T) {
+	tests := []struct {
+		name string
+		jd   Date
+		want float64
+	}{
+		{"before 1961", Date(2_437_000.5), 0},
+		{"1961", Date(2_437_300.5), 1.4228180},
+		{"1968 drift", Date(2_440_000.5), 4.2131700 + (40000-39126)*0.002592},
+		{"1972", Date(2_441_317.5), 10},
+		{"J2000", Date(2_451_545), 32},
+		{"June 30, 2015", Date(2_457_204.49), 35},
+		{"July 1, 2015", Date(2_457_204.5), 36},
+		{"today", Date(2_461_000.5), 37},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := TAIMinusUTCAt(tt.jd); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("TAIMinusUTCAt(%v) = %v, want %v", tt.jd, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTAIMinusUTC(t *testing.T) {
+	tests := []struct {
+		name string
+		jd   Date
+		want int
+		ok   bool
+	}{
+		{"1968 drift", Date(2_440_000.5), 0, false},
+		{"1972", Date(2_441_317.5), 10, true},
+		{"June 30, 2015", Date(2_457_204.49), 35, true},
+		{"July 1, 2015", Date(2_457_204.5), 36, true},
+		{"today", Date(2_461_000.5), 37, true},
+		{"October 2026", Date(2_461_300.5), 37, true},
+		{"expired", Date(2_461_600.5), 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := TAIMinusUTC(tt.jd)
+			if got != tt.want || ok != tt.ok {
+				t.Errorf("TAIMinusUTC(%v) = %v, %v, want %v, %v", tt.jd, got, ok, tt.want, tt.ok)
+			}
+		})
+	}
+}
+
+func TestNextLeapSecondAfter(t *testing.T) {
+	tests := []struct {
+		name string
+		jd   Date
+		want Date
+		ok   bool
+	}{
+		{"before 1972", Date(2_437_000.5), 2_441_499.5, true},
+		{"1972", Date(2_441_317.5), 2_441_499.5, true},
+		{"2013", Date(2_456_300.5), 2_457_204.5, true},
+		{"at leap", Date(2_457_204.5), 2_457_754.5, true},
+		{"after last", Date(2_457_754.5), 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := NextLeapSecondAfter(tt.jd)
+			if got != tt.want || ok != tt.ok {
+				t.Errorf("NextLeapSecondAfter(%v) = %v, %v, want %v, %v", tt.jd, got, ok, tt.want, tt.ok)
+			}
+		})
+	}
+}
+
+func TestSetLeapSeconds(t *testing.T) {
+	defer SetLeapSeconds(nil)
+	table := &LeapTable{Entries: []LeapSecond{{Start: 2_441_317.5, Offset: 10}, {Start: 2_460_000.5, Offset: 38}}}
+	SetLeapSeconds(table)
+	if got := TAIMinusUTCAt(2_460_001); got != 38 {
+		t.Errorf("TAIMinusUTCAt() = %v with custom table, want 38", got)
+	}
+	if got := TAIMinusUTCAt(2_451_545); got != 10 {
+		t.Errorf("TAIMinusUTCAt() = %v with custom table, want 10", got)
+	}
+	if got := TAIMinusUTCAt(2_437_300.5); got != 1.4228180 {
+		t.Errorf("TAIMinusUTCAt() = %v before custom table, want early UTC", got)
+	}
+	SetLeapSeconds(nil)
+	if got := TAIMinusUTCAt(2_460_001); got != 37 {
+		t.Errorf("TAIMinusUTCAt() = %v after reset, want 37", got)
+	}
+}
+
+func TestLeapTable(t *testing.T) {
+	table := LeapSeconds()
+	if got := table.TAIMinusUTCAt(2_437_300.5); got != 0 {
+		t.Errorf("LeapTable.TAIMinusUTCAt(1961) = %v, want 0", got)
+	}
+	utc := Date(2_451_545)
+	tai := table.UTCToTAI(utc)
+	if !equalSeconds(tai, utc+32.0/day_seconds, 1e-4) {
+		t.Errorf("LeapTable.UTCToTAI() = %v, want UTC+32s", tai)
+	}
+	if got := table.TAIToUTC(tai); !equalSeconds(got, utc, 1e-4) {
+		t.Errorf("LeapTable.TAIToUTC() = %v, want %v", got, utc)
+	}
+	if table.Expired(table.Expires - 1) {
+		t.Errorf("LeapTable.Expired() before expiry")
+	}
+	if !table.Expired(table.Expires) {
+		t.Errorf("LeapTable.Expired() at expiry = false")
+	}
+	if (&LeapTable{}).Expired(1e9) {
+		t.Errorf("LeapTable.Expired() without an expiration date")
+	}
+}
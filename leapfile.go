@@ -0,0 +1,165 @@
+package julian
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ntp_epoch is the julian date of the NTP epoch, January 1, 1900.
+const ntp_epoch = 2415020.5
+
+// ParseLeapSecondsList parses a leap second table in the format of the NIST
+// and IETF leap-seconds.list file. If the file carries a "#h" hash line, the
+// hash is verified.
+func ParseLeapSecondsList(r io.Reader) (*LeapTable, error) {
+	t := &LeapTable{}
+	var (
+		hashed strings.Builder
+		hash   string
+		sc     = bufio.NewScanner(r)
+	)
+	for n := 1; sc.Scan(); n++ {
+		line := sc.Text()
+		switch {
+		case strings.HasPrefix(line, "#$"), strings.HasPrefix(line, "#@"):
+			v := strings.TrimSpace(line[2:])
+			sec, err := strconv.ParseInt(v, 10, 64)
+			if err != nil {
+				return nil, leapLineError(n, line)
+			}
+			if line[1] == '$' {
+				t.Updated = fromNTP(sec)
+			} else {
+				t.Expires = fromNTP(sec)
+			}
+			hashed.WriteString(v)
+			continue
+		case strings.HasPrefix(line, "#h"):
+			hash = strings.Join(strings.Fields(line[2:]), "")
+			continue
+		case strings.HasPrefix(line, "#"):
+			if v, ok := strings.CutPrefix(strings.TrimSpace(line[1:]), "Updated through "); ok {
+				t.Version = strings.TrimSpace(v)
+			}
+			continue
+		}
+		data, _, _ := strings.Cut(line, "#")
+		f := strings.Fields(data)
+		if len(f) == 0 {
+			continue
+		}
+		if len(f) != 2 {
+			return nil, leapLineError(n, line)
+		}
+		sec, err1 := strconv.ParseInt(f[0], 10, 64)
+		off, err2 := strconv.Atoi(f[1])
+		if err1 != nil || err2 != nil {
+			return nil, leapLineError(n, line)
+		}
+		hashed.WriteString(f[0])
+		hashed.WriteString(f[1])
+		t.Entries = append(t.Entries, LeapSecond{Start: fromNTP(sec), Offset: float64(off)})
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	if hash != "" {
+		sum := sha1.Sum([]byte(hashed.String()))
+		if !strings.EqualFold(hash, hex.EncodeToString(sum[:])) {
+			return nil, fmt.Errorf("%w: leap-seconds.list hash mismatch", ErrSyntax)
+		}
+	}
+	return t, nil
+}
+
+// ParseIERSLeapSecondDat parses a leap second table in the format of the
+// IERS Leap_Second.dat file, whose data lines give the MJD, the day, month
+// and year, and TAI-UTC of each leap second.
+func ParseIERSLeapSecondDat(r io.Reader) (*LeapTable, error) {
+	t := &LeapTable{}
+	sc := bufio.NewScanner(r)
+	for n := 1; sc.Scan(); n++ {
+		line := sc.Text()
+		if c, ok := strings.CutPrefix(line, "#"); ok {
+			c = strings.TrimSpace(c)
+			if v, ok := strings.CutPrefix(c, "File expires on "); ok {
+				exp, err := time.Parse("2 January 2006", strings.TrimSpace(v))
+				if err != nil {
+					return nil, leapLineError(n, line)
+				}
+				t.Expires = Time(exp)
+			} else if v, ok := strings.CutPrefix(c, "Updated through "); ok {
+				t.Version = strings.TrimSpace(v)
+			}
+			continue
+		}
+		f := strings.Fields(line)
+		if len(f) == 0 {
+			continue
+		}
+		if len(f) != 5 {
+			return nil, leapLineError(n, line)
+		}
+		mjd, err1 := strconv.ParseFloat(f[0], 64)
+		off, err2 := strconv.ParseFloat(f[4], 64)
+		if err1 != nil || err2 != nil {
+			return nil, leapLineError(n, line)
+		}
+		t.Entries = append(t.Entries, LeapSecond{Start: Date(mjd + mjd_offset), Offset: off})
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// ParseTAIUTC parses a leap second table in the format of the USNO
+// tai-utc.dat file, which includes the drifting offsets of UTC before 1972.
+// A line reads, for example,
+//
+//	1961 JAN  1 =JD 2437300.5  TAI-UTC=   1.4228180 S + (MJD - 37300.) X 0.001296       S
+func ParseTAIUTC(r io.Reader) (*LeapTable, error) {
+	t := &LeapTable{}
+	sc := bufio.NewScanner(r)
+	for n := 1; sc.Scan(); n++ {
+		line := sc.Text()
+		f := strings.Fields(line)
+		if len(f) == 0 {
+			continue
+		}
+		if len(f) != 15 || f[3] != "=JD" || f[5] != "TAI-UTC=" || f[9] != "(MJD" || f[12] != "X" {
+			return nil, leapLineError(n, line)
+		}
+		jd, err1 := strconv.ParseFloat(f[4], 64)
+		off, err2 := strconv.ParseFloat(f[6], 64)
+		base, err3 := strconv.ParseFloat(strings.TrimSuffix(f[11], ")"), 64)
+		rate, err4 := strconv.ParseFloat(f[13], 64)
+		if err1 != nil || err2 != nil || err3 != nil || err4 != nil {
+			return nil, leapLineError(n, line)
+		}
+		if rate == 0 {
+			base = 0
+		}
+		t.Entries = append(t.Entries, LeapSecond{Date(jd), off, base, rate})
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func leapLineError(n int, line string) error {
+	return fmt.Errorf("%w: line %d: %q", ErrSyntax, n, line)
+}
+
+// fromNTP returns the julian date of sec seconds since the NTP epoch.
+func fromNTP(sec int64) Date {
+	days := floorDiv(sec, day_seconds)
+	return ntp_epoch + Date(days) + Date(float64(sec-days*day_seconds)/day_seconds)
+}
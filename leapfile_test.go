@@ -0,0 +1,119 @@
+package julian
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestParseLeapSecondsList(t *testing.T) {
+	in := `#	Updated through IERS Bulletin C 70
+#$	 3960403200
+#@	3991593600
+2272060800	10	# 1 Jan 1972
+3692217600	37	# 1 Jan 2017
+`
+	table, err := ParseLeapSecondsList(strings.NewReader(in))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []LeapSecond{{Start: 2_441_317.5, Offset: 10}, {Start: 2_457_754.5, Offset: 37}}
+	if len(table.Entries) != len(want) || table.Entries[0] != want[0] || table.Entries[1] != want[1] {
+		t.Errorf("ParseLeapSecondsList() entries = %v, want %v", table.Entries, want)
+	}
+	if table.Version != "IERS Bulletin C 70" {
+		t.Errorf("ParseLeapSecondsList() version = %q", table.Version)
+	}
+	if y, m, d := table.Expires.Date(); y != 2026 || m != 6 || d != 28 {
+		t.Errorf("ParseLeapSecondsList() expires = %d-%d-%d, want 2026-06-28", y, m, d)
+	}
+}
+
+func TestParseLeapSecondsList_errors(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+	}{
+		{"one field", "2272060800\n"},
+		{"bad number", "x 10\n"},
+		{"bad expiry", "#@ soon\n"},
+		{"bad hash", "2272060800 10\n#h 00000000 00000000 00000000 00000000 00000000\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParseLeapSecondsList(strings.NewReader(tt.in)); !errors.Is(err, ErrSyntax) {
+				t.Errorf("ParseLeapSecondsList(%q) error = %v, want ErrSyntax", tt.in, err)
+			}
+		})
+	}
+}
+
+func TestParseIERSLeapSecondDat(t *testing.T) {
+	in := `#  Value of TAI-UTC in second valid beetween the initial value until
+#  the epoch given on the next line. The last line reads that NO
+#  leap second was introduced since the corresponding date
+#  Updated through IERS Bulletin 70 issued in July 2025
+#
+#
+#  File expires on 28 June 2026
+#
+#
+#    MJD        Date        TAI-UTC (s)
+#           day month year
+#    ---    --------------   ------
+#
+    41317.0    1  1 1972       10
+    41499.0    1  7 1972       11
+    57754.0    1  1 2017       37
+`
+	table, err := ParseIERSLeapSecondDat(strings.NewReader(in))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(table.Entries) != 3 {
+		t.Fatalf("ParseIERSLeapSecondDat() = %d entries, want 3", len(table.Entries))
+	}
+	if got := table.Entries[1]; got != (LeapSecond{Start: 2_441_499.5, Offset: 11}) {
+		t.Errorf("ParseIERSLeapSecondDat() entry = %+v", got)
+	}
+	if y, m, d := table.Expires.Date(); y != 2026 || m != 6 || d != 28 {
+		t.Errorf("ParseIERSLeapSecondDat() expires = %d-%d-%d, want 2026-06-28", y, m, d)
+	}
+	if table.Version != "IERS Bulletin 70 issued in July 2025" {
+		t.Errorf("ParseIERSLeapSecondDat() version = %q", table.Version)
+	}
+	if _, err := ParseIERSLeapSecondDat(strings.NewReader("41317.0 1 1 1972\n")); !errors.Is(err, ErrSyntax) {
+		t.Errorf("ParseIERSLeapSecondDat() short line error = %v, want ErrSyntax", err)
+	}
+}
+
+func TestParseTAIUTC(t *testing.T) {
+	in := ` 1961 JAN  1 =JD 2437300.5  TAI-UTC=   1.4228180 S + (MJD - 37300.) X 0.001296       S
+ 1968 FEB  1 =JD 2439887.5  TAI-UTC=   4.2131700 S + (MJD - 39126.) X 0.002592       S
+ 1972 JAN  1 =JD 2441317.5  TAI-UTC=  10.0       S + (MJD - 41317.) X 0.0      S
+ 2017 JAN  1 =JD 2457754.5  TAI-UTC=  37.0       S + (MJD - 41317.) X 0.0      S
+`
+	table, err := ParseTAIUTC(strings.NewReader(in))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []LeapSecond{
+		{2_437_300.5, 1.4228180, 37300, 0.001296},
+		{2_439_887.5, 4.2131700, 39126, 0.002592},
+		{2_441_317.5, 10, 0, 0},
+		{2_457_754.5, 37, 0, 0},
+	}
+	for i := range want {
+		if table.Entries[i] != want[i] {
+			t.Errorf("ParseTAIUTC() entry %d = %+v, want %+v", i, table.Entries[i], want[i])
+		}
+	}
+	for _, jd := range []Date{2_437_400.5, 2_440_000.5, 2_441_317.5} {
+		if got, want := table.TAIMinusUTCAt(jd), TAIMinusUTCAt(jd); got != want {
+			t.Errorf("LeapTable.TAIMinusUTCAt(%v) = %v, want %v", jd, got, want)
+		}
+	}
+	if _, err := ParseTAIUTC(strings.NewReader(" 1961 JAN  1 =JD 2437300.5\n")); !errors.Is(err, ErrSyntax) {
+		t.Errorf("ParseTAIUTC() short line error = %v, want ErrSyntax", err)
+	}
+}
@@ -0,0 +1,104 @@
+package julian
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"fmt"
+	"time"
+)
+
+// NullDate represents a julian date that may be null. NullDate implements
+// the sql.Scanner interface so it can be used as a scan destination, similar
+// to sql.NullTime.
+type NullDate struct {
+	Date  Date
+	Valid bool // Valid is true if Date is not NULL
+}
+
+// NewNullDate returns a valid NullDate holding jd.
+func NewNullDate(jd Date) NullDate {
+	return NullDate{Date: jd, Valid: true}
+}
+
+// Scan implements the sql.Scanner interface. It accepts NULL, numbers, time
+// values and strings in the forms understood by Parse.
+func (n *NullDate) Scan(value any) error {
+	var err error
+	switch v := value.(type) {
+	case nil:
+		*n = NullDate{}
+		return nil
+	case float64:
+		n.Date = Date(v)
+	case int64:
+		n.Date = Date(v)
+	case time.Time:
+		n.Date = Time(v)
+	case string:
+		n.Date, err = Parse(v)
+	case []byte:
+		n.Date, err = Parse(string(v))
+	default:
+		return fmt.Errorf("julian: cannot scan %T into NullDate", value)
+	}
+	n.Valid = err == nil
+	return err
+}
+
+// Value implements the driver.Valuer interface. A valid date is stored as
+// its float64 value.
+func (n NullDate) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return float64(n.Date), nil
+}
+
+// MarshalJSON implements the json.Marshaler interface. A null date is encoded
+// as null and a valid date as Date.MarshalJSON encodes it.
+func (n NullDate) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return n.Date.MarshalJSON()
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It accepts null, a
+// JSON number, or a JSON string in the forms understood by Parse.
+func (n *NullDate) UnmarshalJSON(data []byte) error {
+	if string(bytes.TrimSpace(data)) == "null" {
+		*n = NullDate{}
+		return nil
+	}
+	var jd Date
+	if err := jd.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	*n = NullDate{Date: jd, Valid: true}
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface. A null date
+// is encoded as the empty string and a valid date as Date.MarshalText
+// encodes it.
+func (n NullDate) MarshalText() ([]byte, error) {
+	if !n.Valid {
+		return []byte{}, nil
+	}
+	return n.Date.MarshalText()
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface. The empty
+// string decodes as a null date.
+func (n *NullDate) UnmarshalText(data []byte) error {
+	if len(bytes.TrimSpace(data)) == 0 {
+		*n = NullDate{}
+		return nil
+	}
+	jd, err := Parse(string(data))
+	if err != nil {
+		return err
+	}
+	*n = NullDate{Date: jd, Valid: true}
+	return nil
+}
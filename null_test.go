@@ -0,0 +1,134 @@
+package julian
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestNullDate_Scan(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   any
+		want    NullDate
+		wantErr bool
+	}{
+		{"nil", nil, NullDate{}, false},
+		{"float", 2_451_545.5, NullDate{Date(2_451_545.5), true}, false},
+		{"int", int64(2_451_545), NullDate{Date(2_451_545), true}, false},
+		{"string", "JD 2451545.25", NullDate{Date(2_451_545.25), true}, false},
+		{"bytes", []byte("MJD 51544.5"), NullDate{Date(2_451_545), true}, false},
+		{"time", time.Date(2000, time.January, 1, 12, 0, 0, 0, time.UTC), NullDate{Date(2_451_545), true}, false},
+		{"bad string", "noon", NullDate{}, true},
+		{"bad type", true, NullDate{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got NullDate
+			err := got.Scan(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NullDate.Scan(%v) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			}
+			if got.Valid != tt.want.Valid || !equalJulian(got.Date, tt.want.Date) {
+				t.Errorf("NullDate.Scan(%v) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNullDate_Value(t *testing.T) {
+	if v, err := (NullDate{}).Value(); err != nil || v != nil {
+		t.Errorf("NullDate{}.Value() = %v, %v, want nil", v, err)
+	}
+	if v, err := NewNullDate(2_451_545.5).Value(); err != nil || v != 2_451_545.5 {
+		t.Errorf("NullDate.Value() = %v, %v, want 2451545.5", v, err)
+	}
+}
+
+func TestNullDate_JSON(t *testing.T) {
+	type record struct {
+		Observed NullDate `json:"observed"`
+	}
+	tests := []struct {
+		name string
+		in   NullDate
+		want string
+	}{
+		{"null", NullDate{}, `{"observed":null}`},
+		{"valid", NewNullDate(2_451_545.25), `{"observed":2451545.25}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := json.Marshal(record{tt.in})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(b) != tt.want {
+				t.Errorf("json.Marshal() = %s, want %s", b, tt.want)
+			}
+			var got record
+			if err := json.Unmarshal(b, &got); err != nil {
+				t.Fatal(err)
+			}
+			if got.Observed != tt.in {
+				t.Errorf("json.Unmarshal(%s) = %v, want %v", b, got.Observed, tt.in)
+			}
+		})
+	}
+}
+
+func TestNullDate_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    NullDate
+		wantErr bool
+	}{
+		{"number", `2451545`, NewNullDate(2_451_545), false},
+		{"string", `"JD 2451545.5"`, NewNullDate(2_451_545.5), false},
+		{"null", `null`, NullDate{}, false},
+		{"bool", `true`, NullDate{}, true},
+		{"bad string", `"noon"`, NullDate{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got NullDate
+			err := got.UnmarshalJSON([]byte(tt.data))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NullDate.UnmarshalJSON(%s) error = %v, wantErr %v", tt.data, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("NullDate.UnmarshalJSON(%s) = %v, want %v", tt.data, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNullDate_Text(t *testing.T) {
+	tests := []struct {
+		name string
+		in   NullDate
+		want string
+	}{
+		{"null", NullDate{}, ""},
+		{"valid", NewNullDate(2_455_241.72291667), "2455241.72291667"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := tt.in.MarshalText()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(b) != tt.want {
+				t.Errorf("NullDate.MarshalText() = %q, want %q", b, tt.want)
+			}
+			got := NewNullDate(1)
+			if err := got.UnmarshalText(b); err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.in {
+				t.Errorf("NullDate.UnmarshalText(%q) = %v, want %v", b, got, tt.in)
+			}
+		})
+	}
+}
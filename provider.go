@@ -0,0 +1,150 @@
+package julian
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// A LeapSecondProvider supplies a leap second table, for example from a file
+// that an organization mirrors or from the network.
+type LeapSecondProvider interface {
+	LeapSeconds(ctx context.Context) (*LeapTable, error)
+}
+
+// EmbeddedLeapSeconds is a LeapSecondProvider that returns the table embedded
+// in the package, as LeapSeconds does.
+var EmbeddedLeapSeconds LeapSecondProvider = embeddedProvider{}
+
+type embeddedProvider struct{}
+
+func (embeddedProvider) LeapSeconds(context.Context) (*LeapTable, error) {
+	return LeapSeconds(), nil
+}
+
+// RefreshLeapSeconds fetches a table from p and makes it the table used by the
+// time scale conversions, as by SetLeapSeconds. A long running service may
+// call it periodically to pick up newly announced leap seconds.
+func RefreshLeapSeconds(ctx context.Context, p LeapSecondProvider) error {
+	t, err := p.LeapSeconds(ctx)
+	if err != nil {
+		return err
+	}
+	SetLeapSeconds(t)
+	return nil
+}
+
+// DefaultLeapSecondsURL is the IANA copy of the leap-seconds.list file.
+const DefaultLeapSecondsURL = "https://data.iana.org/time-zones/tzdb/leap-seconds.list"
+
+// An HTTPLeapSecondProvider fetches a leap second table over HTTP and caches
+// it. The cached table is fetched again once it is older than MaxAge or past
+// its expiration date. If a fetch fails, the cached table, if any, is
+// returned along with the error. After each fetch, whether it succeeded or
+// not, no other is made until RetryInterval has passed; until then the
+// cached table and the error of the last fetch are returned, even if the
+// table is stale.
+//
+// An HTTPLeapSecondProvider is safe for concurrent use. It must not be copied
+// after first use.
+type HTTPLeapSecondProvider struct {
+	URL    string        // defaults to DefaultLeapSecondsURL
+	Client *http.Client  // defaults to http.DefaultClient
+	MaxAge time.Duration // zero keeps a table until it expires
+	// RetryInterval is the minimum time between fetches. Zero selects one
+	// hour and a negative interval allows a fetch on every call.
+	RetryInterval time.Duration
+	// Parse decodes the response body; it defaults to ParseLeapSecondsList.
+	Parse func(io.Reader) (*LeapTable, error)
+
+	mu        sync.Mutex
+	cached    *LeapTable
+	fetched   time.Time     // the last successful fetch
+	attempted time.Time     // the last fetch
+	err       error         // the error of the last fetch
+	inflight  chan struct{} // closed when the fetch in progress ends
+}
+
+const default_retry_interval = time.Hour
+
+// LeapSeconds implements the LeapSecondProvider interface. The fetch is made
+// without holding the provider's lock: while it is in progress, other callers
+// get the cached table, or wait for the fetch if nothing is cached yet.
+func (p *HTTPLeapSecondProvider) LeapSeconds(ctx context.Context) (*LeapTable, error) {
+	p.mu.Lock()
+	now := time.Now()
+	if p.cached != nil && !p.cached.Expired(Time(now)) && (p.MaxAge <= 0 || now.Sub(p.fetched) < p.MaxAge) {
+		defer p.mu.Unlock()
+		return p.cached, nil
+	}
+	if wait := p.inflight; wait != nil {
+		if p.cached != nil {
+			defer p.mu.Unlock()
+			return p.cached, p.err
+		}
+		p.mu.Unlock()
+		select {
+		case <-wait:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		return p.cached, p.err
+	}
+	retry := p.RetryInterval
+	if retry == 0 {
+		retry = default_retry_interval
+	}
+	if !p.attempted.IsZero() && now.Sub(p.attempted) < retry {
+		defer p.mu.Unlock()
+		return p.cached, p.err
+	}
+	p.attempted = now
+	done := make(chan struct{})
+	p.inflight = done
+	p.mu.Unlock()
+
+	t, err := p.fetch(ctx)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.inflight = nil
+	close(done)
+	if p.err = err; err != nil {
+		return p.cached, err
+	}
+	p.cached, p.fetched = t, now
+	return t, nil
+}
+
+func (p *HTTPLeapSecondProvider) fetch(ctx context.Context) (*LeapTable, error) {
+	url := p.URL
+	if url == "" {
+		url = DefaultLeapSecondsURL
+	}
+	client := p.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	parse := p.Parse
+	if parse == nil {
+		parse = ParseLeapSecondsList
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("julian: fetching %s: %s", url, resp.Status)
+	}
+	return parse(resp.Body)
+}
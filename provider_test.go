@@ -0,0 +1,189 @@
+package julian
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+const testLeapList = `#@	9999999999
+2272060800	10	# 1 Jan 1972
+3692217600	37	# 1 Jan 2017
+`
+
+func TestHTTPLeapSecondProvider(t *testing.T) {
+	var hits atomic.Int32
+	fail := atomic.Bool{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		if fail.Load() {
+			http.Error(w, "down", http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(testLeapList))
+	}))
+	defer srv.Close()
+
+	p := &HTTPLeapSecondProvider{URL: srv.URL, Client: srv.Client(), MaxAge: time.Hour}
+	ctx := context.Background()
+	table, err := p.LeapSeconds(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(table.Entries) != 2 || table.Entries[1].Offset != 37 {
+		t.Errorf("LeapSeconds() = %+v", table.Entries)
+	}
+	if _, err := p.LeapSeconds(ctx); err != nil || hits.Load() != 1 {
+		t.Errorf("LeapSeconds() fetched %d times, want 1 (cached), err %v", hits.Load(), err)
+	}
+
+	// a stale cache is not refetched within the retry interval
+	p.MaxAge = time.Nanosecond
+	fail.Store(true)
+	if got, err := p.LeapSeconds(ctx); err != nil || got != table || hits.Load() != 1 {
+		t.Errorf("LeapSeconds() = %v, %v, fetched %d times within the retry interval, want cached table, 1", got, err, hits.Load())
+	}
+
+	// once it has passed, a stale cache is refetched, and kept when the
+	// fetch fails
+	p.RetryInterval = -1
+	got, err := p.LeapSeconds(ctx)
+	if err == nil {
+		t.Errorf("LeapSeconds() error = nil with failing server")
+	}
+	if got != table {
+		t.Errorf("LeapSeconds() = %v after failure, want cached table", got)
+	}
+	if hits.Load() != 2 {
+		t.Errorf("LeapSeconds() fetched %d times, want 2", hits.Load())
+	}
+
+	// the failure is remembered for the retry interval
+	p.RetryInterval = time.Hour
+	if got, err := p.LeapSeconds(ctx); err == nil || got != table || hits.Load() != 2 {
+		t.Errorf("LeapSeconds() = %v, %v, fetched %d times after a failure, want cached table, error, 2", got, err, hits.Load())
+	}
+}
+
+func TestHTTPLeapSecondProvider_expired(t *testing.T) {
+	var hits atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		w.Write([]byte("#@\t3000000000\n2272060800\t10\n"))
+	}))
+	defer srv.Close()
+
+	p := &HTTPLeapSecondProvider{URL: srv.URL, Client: srv.Client(), MaxAge: time.Hour}
+	for range 3 {
+		if table, err := p.LeapSeconds(context.Background()); err != nil || table == nil {
+			t.Fatalf("LeapSeconds() = %v, %v", table, err)
+		}
+	}
+	if hits.Load() != 1 {
+		t.Errorf("LeapSeconds() fetched an expired table %d times within the retry interval, want 1", hits.Load())
+	}
+
+	p.RetryInterval = -1
+	for range 2 {
+		if _, err := p.LeapSeconds(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if hits.Load() != 3 {
+		t.Errorf("LeapSeconds() fetched an expired table %d times, want 3", hits.Load())
+	}
+}
+
+func TestHTTPLeapSecondProvider_slowFetch(t *testing.T) {
+	var hits atomic.Int32
+	started := make(chan struct{}, 4)
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if hits.Add(1) > 1 {
+			started <- struct{}{}
+			<-release
+		}
+		w.Write([]byte(testLeapList))
+	}))
+	defer srv.Close()
+	defer close(release)
+
+	ctx := context.Background()
+	p := &HTTPLeapSecondProvider{URL: srv.URL, Client: srv.Client(), MaxAge: time.Nanosecond, RetryInterval: -1}
+	table, err := p.LeapSeconds(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// while one caller's refresh hangs, the others get the cached table
+	go p.LeapSeconds(ctx)
+	<-started
+	for range 3 {
+		if got, err := p.LeapSeconds(ctx); got != table || err != nil {
+			t.Errorf("LeapSeconds() during a fetch = %v, %v, want cached table", got, err)
+		}
+	}
+	if hits.Load() != 2 {
+		t.Errorf("LeapSeconds() fetched %d times during a fetch, want 2", hits.Load())
+	}
+}
+
+func TestHTTPLeapSecondProvider_waitForFirstFetch(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-release
+		w.Write([]byte(testLeapList))
+	}))
+	defer srv.Close()
+
+	p := &HTTPLeapSecondProvider{URL: srv.URL, Client: srv.Client()}
+	first := make(chan *LeapTable)
+	go func() {
+		table, _ := p.LeapSeconds(context.Background())
+		first <- table
+	}()
+	<-started
+
+	// with nothing cached a caller waits for the fetch, or for its context
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	if got, err := p.LeapSeconds(ctx); got != nil || err != context.DeadlineExceeded {
+		t.Errorf("LeapSeconds() = %v, %v, want the context error", got, err)
+	}
+	second := make(chan *LeapTable)
+	go func() {
+		table, _ := p.LeapSeconds(context.Background())
+		second <- table
+	}()
+	close(release)
+	if a, b := <-first, <-second; a == nil || a != b {
+		t.Errorf("LeapSeconds() = %v and %v, want the one fetched table", a, b)
+	}
+}
+
+func TestRefreshLeapSeconds(t *testing.T) {
+	defer SetLeapSeconds(nil)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(testLeapList + "3900000000\t38\n"))
+	}))
+	defer srv.Close()
+
+	p := &HTTPLeapSecondProvider{URL: srv.URL, Client: srv.Client()}
+	if err := RefreshLeapSeconds(context.Background(), p); err != nil {
+		t.Fatal(err)
+	}
+	if got := TAIMinusUTCAt(fromNTP(3_900_000_000) + 1); got != 38 {
+		t.Errorf("TAIMinusUTCAt() = %v after refresh, want 38", got)
+	}
+	if err := RefreshLeapSeconds(context.Background(), EmbeddedLeapSeconds); err != nil {
+		t.Fatal(err)
+	}
+	if got := TAIMinusUTCAt(fromNTP(3_900_000_000) + 1); got != 37 {
+		t.Errorf("TAIMinusUTCAt() = %v with embedded table, want 37", got)
+	}
+}
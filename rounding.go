@@ -0,0 +1,94 @@
+package julian
+
+import (
+	"math"
+	"strconv"
+)
+
+// A RoundingMode selects how a julian date is rounded when it is converted
+// to an integer count, such as Unix seconds or a julian day number.
+type RoundingMode int
+
+const (
+	RoundFloor    RoundingMode = iota // toward negative infinity
+	RoundTruncate                     // toward zero
+	RoundHalfUp                       // to nearest, halves toward positive infinity
+	RoundHalfEven                     // to nearest, halves to even
+)
+
+// String returns the name of the rounding mode.
+func (r RoundingMode) String() string {
+	switch r {
+	case RoundFloor:
+		return "RoundFloor"
+	case RoundTruncate:
+		return "RoundTruncate"
+	case RoundHalfUp:
+		return "RoundHalfUp"
+	case RoundHalfEven:
+		return "RoundHalfEven"
+	}
+	return "RoundingMode(" + strconv.Itoa(int(r)) + ")"
+}
+
+// UnixRound returns the julian date as a Unix time in seconds, rounded with
+// the given mode. Unix is UnixRound(RoundFloor).
+func (jd Date) UnixRound(mode RoundingMode) int64 {
+	return jd.unixRound(1e9, mode)
+}
+
+// UnixMilliRound returns the julian date as a Unix time in milliseconds,
+// rounded with the given mode.
+func (jd Date) UnixMilliRound(mode RoundingMode) int64 {
+	return jd.unixRound(1e6, mode)
+}
+
+// UnixMicroRound returns the julian date as a Unix time in microseconds,
+// rounded with the given mode.
+func (jd Date) UnixMicroRound(mode RoundingMode) int64 {
+	return jd.unixRound(1e3, mode)
+}
+
+// unixRound returns jd as a Unix time in multiples of unit nanoseconds. The
+// date is first rounded to the nearest nanosecond, as by civil.
+func (jd Date) unixRound(unit int64, mode RoundingMode) int64 {
+	days, nsec := jd.civil()
+	n := days*(day_nanoseconds/unit) + nsec/unit
+	r := nsec % unit // always >= 0, so n is the floor
+	if r == 0 {
+		return n
+	}
+	switch mode {
+	case RoundTruncate:
+		if n < 0 {
+			n++
+		}
+	case RoundHalfUp:
+		if 2*r >= unit {
+			n++
+		}
+	case RoundHalfEven:
+		if 2*r > unit || 2*r == unit && n%2 != 0 {
+			n++
+		}
+	}
+	return n
+}
+
+// JDNRound returns jd rounded to an integer julian day with the given mode.
+// JDN is JDNRound(RoundHalfUp), while RoundFloor gives the julian day that
+// began at the preceding noon.
+func (jd Date) JDNRound(mode RoundingMode) int64 {
+	x := float64(jd)
+	switch mode {
+	case RoundTruncate:
+		x = math.Trunc(x)
+	case RoundHalfUp:
+		x = math.Floor(x + 0.5)
+	case RoundHalfEven:
+		x = math.RoundToEven(x)
+	default:
+		x = math.Floor(x)
+	}
+	return int64(x)
+}
@@ -0,0 +1,100 @@
+package julian
+
+import "testing"
+
+func TestJulianDate_UnixRound(t *testing.T) {
+	// offsets that are a whole number of 1/256 days are exact in a Date
+	tests := []struct {
+		name                   string
+		jd                     Date
+		floor, trunc, up, even int64
+	}{
+		{"whole", Date(julian_unix + 1.0/128), 675, 675, 675, 675},
+		{"odd half", Date(julian_unix + 1.0/256), 337, 337, 338, 338},
+		{"even half", Date(julian_unix + 3.0/256), 1012, 1012, 1013, 1012},
+		{"below half", Date(julian_unix + 1.0/512), 168, 168, 169, 169},
+		{"negative half", Date(julian_unix - 1.0/256), -338, -337, -337, -338},
+		{"negative", Date(julian_unix - 1.0/512), -169, -168, -169, -169},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for mode, want := range map[RoundingMode]int64{
+				RoundFloor:    tt.floor,
+				RoundTruncate: tt.trunc,
+				RoundHalfUp:   tt.up,
+				RoundHalfEven: tt.even,
+			} {
+				if got := tt.jd.UnixRound(mode); got != want {
+					t.Errorf("JulianDate.UnixRound(%v) = %d, want %d", mode, got, want)
+				}
+			}
+			if got := tt.jd.Unix(); got != tt.floor {
+				t.Errorf("JulianDate.Unix() = %d, want %d", got, tt.floor)
+			}
+		})
+	}
+}
+
+func TestJulianDate_UnixMilliRound(t *testing.T) {
+	jd := Date(julian_unix + 1.0/2048) // 42187.5 ms
+	tests := []struct {
+		mode  RoundingMode
+		milli int64
+		micro int64
+	}{
+		{RoundFloor, 42_187, 42_187_500},
+		{RoundTruncate, 42_187, 42_187_500},
+		{RoundHalfUp, 42_188, 42_187_500},
+		{RoundHalfEven, 42_188, 42_187_500},
+	}
+	for _, tt := range tests {
+		t.Run(tt.mode.String(), func(t *testing.T) {
+			if got := jd.UnixMilliRound(tt.mode); got != tt.milli {
+				t.Errorf("JulianDate.UnixMilliRound() = %d, want %d", got, tt.milli)
+			}
+			if got := jd.UnixMicroRound(tt.mode); got != tt.micro {
+				t.Errorf("JulianDate.UnixMicroRound() = %d, want %d", got, tt.micro)
+			}
+		})
+	}
+}
+
+func TestJulianDate_JDNRound(t *testing.T) {
+	tests := []struct {
+		name                   string
+		jd                     Date
+		floor, trunc, up, even int64
+	}{
+		{"noon", Date(2_451_545), 2_451_545, 2_451_545, 2_451_545, 2_451_545},
+		{"midnight", Date(2_451_545.5), 2_451_545, 2_451_545, 2_451_546, 2_451_546},
+		{"even midnight", Date(2_451_544.5), 2_451_544, 2_451_544, 2_451_545, 2_451_544},
+		{"evening", Date(2_451_545.25), 2_451_545, 2_451_545, 2_451_545, 2_451_545},
+		{"negative", Date(-1.5), -2, -1, -1, -2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for mode, want := range map[RoundingMode]int64{
+				RoundFloor:    tt.floor,
+				RoundTruncate: tt.trunc,
+				RoundHalfUp:   tt.up,
+				RoundHalfEven: tt.even,
+			} {
+				if got := tt.jd.JDNRound(mode); got != want {
+					t.Errorf("JulianDate.JDNRound(%v) = %d, want %d", mode, got, want)
+				}
+			}
+			if got := tt.jd.JDN(); got != tt.up {
+				t.Errorf("JulianDate.JDN() = %d, want %d", got, tt.up)
+			}
+		})
+	}
+}
+
+func TestRoundingMode_String(t *testing.T) {
+	if got := RoundHalfEven.String(); got != "RoundHalfEven" {
+		t.Errorf("RoundHalfEven.String() = %q", got)
+	}
+	if got := RoundingMode(9).String(); got != "RoundingMode(9)" {
+		t.Errorf("RoundingMode(9).String() = %q", got)
+	}
+}
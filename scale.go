@@ -0,0 +1,255 @@
+package julian
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// A TimeScale names the time scale on which a julian date is measured. A
+// Date carries no scale of its own; the conversion functions take the scale
+// of their argument from their name.
+type TimeScale int
+
+const (
+	UTC TimeScale = iota // Coordinated Universal Time
+	TAI                  // International Atomic Time
+	TT                   // Terrestrial Time
+	TDB                  // Barycentric Dynamical Time
+	UT1                  // Universal Time, from the Earth's rotation
+	GPS                  // GPS time
+	TCG                  // Geocentric Coordinate Time
+	TCB                  // Barycentric Coordinate Time
+)
+
+// ErrScale indicates that a conversion between time scales is not supported.
+var ErrScale = errors.New("julian: unsupported time scale conversion")
+
+const (
+	tt_minus_tai  = 32.184 // seconds
+	tai_minus_gps = 19     // seconds
+)
+
+// String returns the abbreviation of the time scale.
+func (s TimeScale) String() string {
+	switch s {
+	case UTC:
+		return "UTC"
+	case TAI:
+		return "TAI"
+	case TT:
+		return "TT"
+	case TDB:
+		return "TDB"
+	case UT1:
+		return "UT1"
+	case GPS:
+		return "GPS"
+	case TCG:
+		return "TCG"
+	case TCB:
+		return "TCB"
+	}
+	return "TimeScale(" + strconv.Itoa(int(s)) + ")"
+}
+
+// Convert converts the julian date jd on the time scale from to the time
+// scale to. Conversions to or from UT1 need the observed value of UT1-UTC
+// and return ErrScale; use ApplyDUT1 and RemoveDUT1 instead.
+func Convert(jd Date, from, to TimeScale) (Date, error) {
+	if from == to {
+		return jd, nil
+	}
+	tai, err := toTAI(jd, from)
+	if err != nil {
+		return 0, err
+	}
+	return fromTAI(tai, to)
+}
+
+func toTAI(jd Date, s TimeScale) (Date, error) {
+	switch s {
+	case UTC:
+		return UTCToTAI(jd), nil
+	case TAI:
+		return jd, nil
+	case TT:
+		return TTToTAI(jd), nil
+	case TDB:
+		return TTToTAI(TDBToTT(jd)), nil
+	case GPS:
+		return addSeconds(jd, tai_minus_gps), nil
+	case TCG:
+		return TTToTAI(TCGToTT(jd)), nil
+	case TCB:
+		return TTToTAI(TDBToTT(TCBToTDB(jd))), nil
+	}
+	return 0, fmt.Errorf("%w: from %v", ErrScale, s)
+}
+
+func fromTAI(tai Date, s TimeScale) (Date, error) {
+	switch s {
+	case UTC:
+		return TAIToUTC(tai), nil
+	case TAI:
+		return tai, nil
+	case TT:
+		return TAIToTT(tai), nil
+	case TDB:
+		return TTToTDB(TAIToTT(tai)), nil
+	case GPS:
+		return addSeconds(tai, -tai_minus_gps), nil
+	case TCG:
+		return TTToTCG(TAIToTT(tai)), nil
+	case TCB:
+		return TDBToTCB(TTToTDB(TAIToTT(tai))), nil
+	}
+	return 0, fmt.Errorf("%w: to %v", ErrScale, s)
+}
+
+// addSeconds returns jd plus sec seconds.
+func addSeconds(jd Date, sec float64) Date {
+	return jd + Date(sec/day_seconds)
+}
+
+// UTCToTAI converts a UTC julian date to TAI by adding the accumulated leap
+// seconds. Before 1961, when UTC was first defined, TAI and UTC are treated
+// as equal.
+func UTCToTAI(utc Date) Date {
+	return addSeconds(utc, taiMinusUTC(utc))
+}
+
+// TAIToUTC converts a TAI julian date to UTC. It is the inverse of UTCToTAI,
+// except during an inserted leap second, which has no UTC julian date of its
+// own.
+func TAIToUTC(tai Date) Date {
+	return taiToUTC(tai, taiMinusUTC)
+}
+
+// taiToUTC inverts UTC+offset(UTC) for the given TAI julian date.
+func taiToUTC(tai Date, offset func(Date) float64) Date {
+	utc := addSeconds(tai, -offset(tai))
+	for range 2 {
+		utc = addSeconds(tai, -offset(utc))
+	}
+	return utc
+}
+
+// TAIToTT converts a TAI julian date to Terrestrial Time, TT = TAI + 32.184s.
+func TAIToTT(tai Date) Date {
+	return addSeconds(tai, tt_minus_tai)
+}
+
+// TTToTAI converts a Terrestrial Time julian date to TAI.
+func TTToTAI(tt Date) Date {
+	return addSeconds(tt, -tt_minus_tai)
+}
+
+// TTToTDB converts a Terrestrial Time julian date to Barycentric Dynamical
+// Time using the truncated Fairhead and Bretagnon series of USNO Circular
+// 179, which is accurate to about 10 µs from 1600 to 2200.
+func TTToTDB(tt Date) Date {
+	return addSeconds(tt, tdbMinusTT(tt))
+}
+
+// TDBToTT converts a Barycentric Dynamical Time julian date to Terrestrial
+// Time. It is the inverse of TTToTDB.
+func TDBToTT(tdb Date) Date {
+	return addSeconds(tdb, -tdbMinusTT(tdb))
+}
+
+// tdbMinusTT returns TDB-TT in seconds. The difference between TT and TDB in
+// the argument is far below the precision of the series.
+func tdbMinusTT(jd Date) float64 {
+	t := jd.Century()
+	return 0.001657*math.Sin(628.3076*t+6.2401) +
+		0.000022*math.Sin(575.3385*t+4.2970) +
+		0.000014*math.Sin(1256.6152*t+6.1969) +
+		0.000005*math.Sin(606.9777*t+4.0212) +
+		0.000005*math.Sin(52.9691*t+0.4444) +
+		0.000002*math.Sin(21.3299*t+5.5431) +
+		0.000010*t*math.Sin(628.3076*t+4.2490)
+}
+
+// The IAU defining constants of the coordinate time scales, IAU 2006
+// resolution B3.
+const (
+	coord_t0 = 2443144.5003725 // January 1, 1977 0h TAI, as a TT, TCG or TCB julian date
+	iau_lg   = 6.969290134e-10 // rate of TCG relative to TT
+	iau_lb   = 1.550519768e-8  // rate of TCB relative to TDB
+	iau_tdb0 = -6.55e-5        // TDB-TCB at coord_t0 in seconds
+)
+
+// TTToTCG converts a Terrestrial Time julian date to Geocentric Coordinate
+// Time, which runs faster than TT by the rate LG and coincides with it at
+// 1977 January 1.0 TAI.
+func TTToTCG(tt Date) Date {
+	return tt + Date(iau_lg/(1-iau_lg)*float64(tt-coord_t0))
+}
+
+// TCGToTT converts a Geocentric Coordinate Time julian date to Terrestrial
+// Time. It is the inverse of TTToTCG.
+func TCGToTT(tcg Date) Date {
+	return tcg - Date(iau_lg*float64(tcg-coord_t0))
+}
+
+// TDBToTCB converts a Barycentric Dynamical Time julian date to Barycentric
+// Coordinate Time, which runs faster than TDB by the rate LB.
+func TDBToTCB(tdb Date) Date {
+	d := float64(tdb-coord_t0) - iau_tdb0/day_seconds
+	return tdb + Date(iau_lb/(1-iau_lb)*d-iau_tdb0/day_seconds)
+}
+
+// TCBToTDB converts a Barycentric Coordinate Time julian date to Barycentric
+// Dynamical Time. It is the inverse of TDBToTCB.
+func TCBToTDB(tcb Date) Date {
+	return tcb - Date(iau_lb*float64(tcb-coord_t0)) + Date(iau_tdb0/day_seconds)
+}
+
+// UTCToGPS converts a UTC julian date to GPS time, which runs at TAI-19s.
+func UTCToGPS(utc Date) Date {
+	return addSeconds(UTCToTAI(utc), -tai_minus_gps)
+}
+
+// GPSToUTC converts a GPS time julian date to UTC.
+func GPSToUTC(gps Date) Date {
+	return TAIToUTC(addSeconds(gps, tai_minus_gps))
+}
+
+// ApplyDUT1 converts a UTC julian date to UT1 given the observed value of
+// UT1-UTC in seconds, as published by the IERS.
+func ApplyDUT1(utc Date, dut1 float64) Date {
+	return addSeconds(utc, dut1)
+}
+
+// RemoveDUT1 converts a UT1 julian date to UTC given UT1-UTC in seconds. It is
+// the inverse of ApplyDUT1.
+func RemoveDUT1(ut1 Date, dut1 float64) Date {
+	return addSeconds(ut1, -dut1)
+}
+
+// TT converts the UTC julian date jd to Terrestrial Time, applying the leap
+// seconds TAI-UTC and the 32.184s of TT-TAI. It is the date that ephemeris
+// formulas expect.
+func (jd Date) TT() Date {
+	return TAIToTT(UTCToTAI(jd))
+}
+
+// FromTT converts a Terrestrial Time julian date to UTC. It is the inverse of
+// TT.
+func FromTT(tt Date) Date {
+	return TAIToUTC(TTToTAI(tt))
+}
+
+// TDB converts the UTC julian date jd to Barycentric Dynamical Time, the time
+// scale of the solar system ephemerides, by way of TT.
+func (jd Date) TDB() Date {
+	return TTToTDB(jd.TT())
+}
+
+// FromTDB converts a Barycentric Dynamical Time julian date to UTC. It is the
+// inverse of TDB.
+func FromTDB(tdb Date) Date {
+	return FromTT(TDBToTT(tdb))
+}
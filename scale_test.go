@@ -0,0 +1,213 @@
+package julian
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+// equalSeconds reports whether two julian dates are within tol seconds.
+func equalSeconds(got, want Date, tol float64) bool {
+	return math.Abs(float64(got-want))*day_seconds <= tol
+}
+
+func TestUTCToTAI(t *testing.T) {
+	tests := []struct {
+		name string
+		utc  Date
+		want float64 // TAI-UTC in seconds
+	}{
+		{"J2000", Date(2_451_545), 32},
+		{"2017", Date(2_457_754.5), 37},
+		{"before 2017", Date(2_457_754.4), 36},
+		{"1972", Date(2_441_317.5), 10},
+		{"1965 drift", Date(2_438_820.5), 3.6401300 + (38820-38761)*0.001296},
+		{"before UTC", Date(2_430_000.5), 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tai := UTCToTAI(tt.utc)
+			if got := float64(tai-tt.utc) * day_seconds; math.Abs(got-tt.want) > 1e-4 {
+				t.Errorf("UTCToTAI() - UTC = %vs, want %vs", got, tt.want)
+			}
+			if back := TAIToUTC(tai); !equalSeconds(back, tt.utc, 1e-4) {
+				t.Errorf("TAIToUTC(UTCToTAI()) = %v, want %v", back, tt.utc)
+			}
+		})
+	}
+}
+
+func TestTTToTDB(t *testing.T) {
+	for _, tt := range []Date{2_451_545, 2_451_545 + 91, 2_455_241.72} {
+		tdb := TTToTDB(tt)
+		if d := math.Abs(float64(tdb-tt)) * day_seconds; d > 0.0017 {
+			t.Errorf("TTToTDB(%v) - TT = %vs, want under 1.7ms", tt, d)
+		}
+		if back := TDBToTT(tdb); !equalSeconds(back, tt, 1e-6) {
+			t.Errorf("TDBToTT(TTToTDB(%v)) = %v", tt, back)
+		}
+	}
+	// TDB-TT peaks near perihelion at about +1.66 ms
+	if d := float64(TTToTDB(2_451_545+91)-(2_451_545+91)) * day_seconds; d < 0.0016 {
+		t.Errorf("TDB-TT at April 2000 = %vs, want about 0.00166s", d)
+	}
+}
+
+func TestConvert(t *testing.T) {
+	utc := Date(2_451_545)
+	tests := []struct {
+		name     string
+		from, to TimeScale
+		want     Date
+		tol      float64
+		wantErr  bool
+	}{
+		{"same", UTC, UTC, utc, 0, false},
+		{"UTC to TAI", UTC, TAI, utc + 32.0/day_seconds, 1e-4, false},
+		{"UTC to TT", UTC, TT, utc + 64.184/day_seconds, 1e-4, false},
+		{"UTC to GPS", UTC, GPS, utc + 13.0/day_seconds, 1e-4, false},
+		{"UTC to TDB", UTC, TDB, utc + 64.184/day_seconds, 2e-3, false},
+		{"UTC to UT1", UTC, UT1, 0, 0, true},
+		{"unknown", TimeScale(42), UTC, 0, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Convert(utc, tt.from, tt.to)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Convert() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				if !errors.Is(err, ErrScale) {
+					t.Errorf("Convert() error = %v, want ErrScale", err)
+				}
+				return
+			}
+			if !equalSeconds(got, tt.want, tt.tol) {
+				t.Errorf("Convert() = %v, want %v", got, tt.want)
+			}
+			if back, err := Convert(got, tt.to, tt.from); err != nil || !equalSeconds(back, utc, 1e-4) {
+				t.Errorf("Convert() back = %v, %v, want %v", back, err, utc)
+			}
+		})
+	}
+}
+
+func TestGPS(t *testing.T) {
+	utc := Date(2_457_754.5)
+	if got := UTCToGPS(utc); !equalSeconds(got, utc+18.0/day_seconds, 1e-4) {
+		t.Errorf("UTCToGPS() = %v, want UTC+18s", got)
+	}
+	if got := GPSToUTC(UTCToGPS(utc)); !equalSeconds(got, utc, 1e-4) {
+		t.Errorf("GPSToUTC(UTCToGPS()) = %v, want %v", got, utc)
+	}
+}
+
+func TestApplyDUT1(t *testing.T) {
+	utc := Date(2_451_545)
+	ut1 := ApplyDUT1(utc, 0.3554)
+	if !equalSeconds(ut1, utc+0.3554/day_seconds, 1e-5) {
+		t.Errorf("ApplyDUT1() = %v", ut1)
+	}
+	if got := RemoveDUT1(ut1, 0.3554); !equalSeconds(got, utc, 1e-5) {
+		t.Errorf("RemoveDUT1(ApplyDUT1()) = %v, want %v", got, utc)
+	}
+}
+
+func TestTimeScale_String(t *testing.T) {
+	for s, want := range map[TimeScale]string{UTC: "UTC", TDB: "TDB", GPS: "GPS", TimeScale(9): "TimeScale(9)"} {
+		if got := s.String(); got != want {
+			t.Errorf("TimeScale.String() = %q, want %q", got, want)
+		}
+	}
+}
+
+func TestJulianDate_TT(t *testing.T) {
+	tests := []struct {
+		name string
+		utc  Date
+		want float64 // TT-UTC in seconds
+	}{
+		{"J2000", Date(2_451_545), 64.184},
+		{"2017", Date(2_457_754.5), 69.184},
+		{"1972", Date(2_441_317.5), 42.184},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.utc.TT()
+			if !equalSeconds(got, tt.utc+Date(tt.want/day_seconds), 1e-4) {
+				t.Errorf("JulianDate.TT() - UTC = %vs, want %vs", float64(got-tt.utc)*day_seconds, tt.want)
+			}
+			if back := FromTT(got); !equalSeconds(back, tt.utc, 1e-4) {
+				t.Errorf("FromTT(TT()) = %v, want %v", back, tt.utc)
+			}
+		})
+	}
+}
+
+func TestTDBMinusTT(t *testing.T) {
+	// TDB-TT is slightly negative at J2000 and reaches its extremes of about
+	// ±1.66 ms in early April and October
+	tests := []struct {
+		name string
+		tt   Date
+		want float64 // seconds
+	}{
+		{"J2000", Date(2_451_545), -0.0000729},
+		{"April 2000", Date(2_451_636), 0.0016514},
+		{"October 2000", Date(2_451_818), -0.0016264},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tdbMinusTT(tt.tt); math.Abs(got-tt.want) > 30e-6 {
+				t.Errorf("tdbMinusTT() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJulianDate_TDB(t *testing.T) {
+	utc := Date(2_451_636)
+	tdb := utc.TDB()
+	if !equalSeconds(tdb, utc+Date((64.184+tdbMinusTT(utc))/day_seconds), 1e-4) {
+		t.Errorf("JulianDate.TDB() - UTC = %vs", float64(tdb-utc)*day_seconds)
+	}
+	if got := FromTDB(tdb); !equalSeconds(got, utc, 1e-4) {
+		t.Errorf("FromTDB(TDB()) = %v, want %v", got, utc)
+	}
+}
+
+func TestTCG(t *testing.T) {
+	// the SOFA test values for iauTttcg and iauTdbtcb
+	tt := Date(2_453_750.5 + 0.892482639)
+	if got, want := TTToTCG(tt), Date(2_453_750.5+0.8924900312508587113); !equalSeconds(got, want, 1e-4) {
+		t.Errorf("TTToTCG() = %.9f, want %.9f", got, want)
+	}
+	if got := TCGToTT(TTToTCG(tt)); !equalSeconds(got, tt, 1e-6) {
+		t.Errorf("TCGToTT(TTToTCG()) = %v, want %v", got, tt)
+	}
+	tdb := Date(2_453_750.5 + 0.892855137)
+	if got, want := TDBToTCB(tdb), Date(2_453_750.5+0.8930195997253656716); !equalSeconds(got, want, 1e-4) {
+		t.Errorf("TDBToTCB() = %.9f, want %.9f", got, want)
+	}
+	if got := TCBToTDB(TDBToTCB(tdb)); !equalSeconds(got, tdb, 1e-6) {
+		t.Errorf("TCBToTDB(TDBToTCB()) = %v, want %v", got, tdb)
+	}
+	// at the defining epoch TCG and TT agree
+	if got := TTToTCG(coord_t0); got != coord_t0 {
+		t.Errorf("TTToTCG(T0) = %v, want %v", got, Date(coord_t0))
+	}
+}
+
+func TestConvert_coordinate(t *testing.T) {
+	utc := Date(2_451_545)
+	for _, s := range []TimeScale{TCG, TCB} {
+		got, err := Convert(utc, UTC, s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		back, err := Convert(got, s, UTC)
+		if err != nil || !equalSeconds(back, utc, 1e-4) {
+			t.Errorf("Convert(%v) back = %v, %v, want %v", s, back, err, utc)
+		}
+	}
+}
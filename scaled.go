@@ -0,0 +1,62 @@
+package julian
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrScaleMismatch indicates an operation on dates of different time scales.
+var ErrScaleMismatch = errors.New("julian: time scales differ")
+
+// A ScaledDate is a julian date tagged with its time scale. Its methods
+// refuse to combine dates of different scales, which catches the silent
+// mixing of UTC and TT; convert one of them with In first.
+type ScaledDate struct {
+	JD    Date
+	Scale TimeScale
+}
+
+// In returns the date converted to the time scale s, as by Convert.
+func (d ScaledDate) In(s TimeScale) (ScaledDate, error) {
+	jd, err := Convert(d.JD, d.Scale, s)
+	if err != nil {
+		return ScaledDate{}, err
+	}
+	return ScaledDate{jd, s}, nil
+}
+
+// Add returns the date d+delta on the same time scale.
+func (d ScaledDate) Add(delta Delta) ScaledDate {
+	return ScaledDate{d.JD.AddDelta(delta), d.Scale}
+}
+
+// Sub returns the difference d-other. It returns ErrScaleMismatch if the
+// dates are on different time scales.
+func (d ScaledDate) Sub(other ScaledDate) (Delta, error) {
+	if err := d.check(other); err != nil {
+		return 0, err
+	}
+	return d.JD.Diff(other.JD), nil
+}
+
+// Compare compares d and other as Date.Compare does. It returns
+// ErrScaleMismatch if the dates are on different time scales.
+func (d ScaledDate) Compare(other ScaledDate) (int, error) {
+	if err := d.check(other); err != nil {
+		return 0, err
+	}
+	return d.JD.Compare(other.JD), nil
+}
+
+func (d ScaledDate) check(other ScaledDate) error {
+	if d.Scale != other.Scale {
+		return fmt.Errorf("%w: %v and %v", ErrScaleMismatch, d.Scale, other.Scale)
+	}
+	return nil
+}
+
+// String returns the date formatted as Date.String does followed by its time
+// scale, e.g. "JD 2451545 TT".
+func (d ScaledDate) String() string {
+	return d.JD.String() + " " + d.Scale.String()
+}
@@ -0,0 +1,42 @@
+package julian
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestScaledDate(t *testing.T) {
+	utc := ScaledDate{Date(2_451_545), UTC}
+	tt, err := utc.In(TT)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tt.Scale != TT || !equalSeconds(tt.JD, utc.JD+64.184/day_seconds, 1e-4) {
+		t.Errorf("ScaledDate.In(TT) = %v", tt)
+	}
+
+	if _, err := tt.Sub(utc); !errors.Is(err, ErrScaleMismatch) {
+		t.Errorf("ScaledDate.Sub() across scales error = %v, want ErrScaleMismatch", err)
+	}
+	if _, err := tt.Compare(utc); !errors.Is(err, ErrScaleMismatch) {
+		t.Errorf("ScaledDate.Compare() across scales error = %v, want ErrScaleMismatch", err)
+	}
+
+	later := utc.Add(1.5)
+	if later.Scale != UTC || later.JD != 2_451_546.5 {
+		t.Errorf("ScaledDate.Add() = %v", later)
+	}
+	if d, err := later.Sub(utc); err != nil || d != 1.5 {
+		t.Errorf("ScaledDate.Sub() = %v, %v, want 1.5", d, err)
+	}
+	if c, err := utc.Compare(later); err != nil || c != -1 {
+		t.Errorf("ScaledDate.Compare() = %v, %v, want -1", c, err)
+	}
+
+	if _, err := utc.In(UT1); !errors.Is(err, ErrScale) {
+		t.Errorf("ScaledDate.In(UT1) error = %v, want ErrScale", err)
+	}
+	if got := later.String(); got != "JD 2451546.5 UTC" {
+		t.Errorf("ScaledDate.String() = %q", got)
+	}
+}
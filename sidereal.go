@@ -0,0 +1,133 @@
+package julian
+
+import (
+	"math"
+	"strconv"
+	"time"
+)
+
+// SiderealDay is the length of a mean sidereal day, the period of the
+// Earth's rotation relative to the mean equinox.
+const SiderealDay time.Duration = 86164_090_500_000 // 23h56m4.0905s
+
+// sidereal_ratio is the number of mean sidereal days in a mean solar day.
+const sidereal_ratio = 1.002737909350795
+
+// SiderealDays returns the number of mean sidereal days in an interval of
+// the given number of mean solar days.
+func SiderealDays(solarDays float64) float64 {
+	return solarDays * sidereal_ratio
+}
+
+// SolarDays returns the number of mean solar days in an interval of the
+// given number of mean sidereal days. It is the inverse of SiderealDays.
+func SolarDays(siderealDays float64) float64 {
+	return siderealDays / sidereal_ratio
+}
+
+// ERA returns the Earth rotation angle, the IAU 2000 measure of the Earth's
+// rotation about its axis, in radians in the range [0, 2π). The argument is
+// a julian date on the UT1 time scale.
+func ERA(ut1 Date) float64 {
+	day, frac := ut1.Split()
+	tu := float64(day-epoch_j2000) + frac
+	// the whole turns of the day are dropped before scaling to keep precision
+	return normRadians(2 * math.Pi * (frac + 0.7790572732640 + 0.00273781191135448*tu))
+}
+
+// normRadians reduces a to the range [0, 2π).
+func normRadians(a float64) float64 {
+	a = math.Mod(a, 2*math.Pi)
+	if a < 0 {
+		a += 2 * math.Pi
+	}
+	return a
+}
+
+// A SiderealModel selects the expression used for Greenwich sidereal time.
+type SiderealModel int
+
+const (
+	IAU2006 SiderealModel = iota // based on the Earth rotation angle
+	IAU1982                      // the classical polynomial in UT1
+)
+
+// String returns the name of the sidereal time model.
+func (m SiderealModel) String() string {
+	switch m {
+	case IAU2006:
+		return "IAU 2006"
+	case IAU1982:
+		return "IAU 1982"
+	}
+	return "SiderealModel(" + strconv.Itoa(int(m)) + ")"
+}
+
+const (
+	sec_to_rad    = 2 * math.Pi / day_seconds // seconds of time to radians
+	arcsec_to_rad = math.Pi / (180 * 3600)    // arcseconds to radians
+)
+
+// GMST returns the Greenwich mean sidereal time in radians in the range
+// [0, 2π). The Earth's rotation is taken from the julian date ut1 on the UT1
+// time scale and, for IAU2006, the precession from the same instant tt on
+// the TT time scale; IAU1982 ignores tt. Use RadiansToHours for the time in
+// hours.
+//
+// Where UT1 is not known to better than a second, UTC may be passed as ut1,
+// and jd.TT() of the same UTC as tt.
+func GMST(ut1, tt Date, model SiderealModel) float64 {
+	if model == IAU1982 {
+		return gmst82(ut1)
+	}
+	t := tt.Century()
+	return normRadians(ERA(ut1) + poly(t, 0.014506, 4612.156534, 1.3915817, -0.00000044, -0.000029956, -0.0000000368)*arcsec_to_rad)
+}
+
+// gmst82 returns the IAU 1982 Greenwich mean sidereal time at ut1.
+func gmst82(ut1 Date) float64 {
+	_, frac := ut1.Split()
+	t := ut1.Century()
+	// the day begins at noon, so half a day is taken from the constant term
+	s := poly(t, 24110.54841-day_seconds/2, 8640184.812866, 0.093104, -6.2e-6)
+	return normRadians((s + frac*day_seconds) * sec_to_rad)
+}
+
+// RadiansToHours converts an angle in radians to hours of time, 2π radians
+// to 24 hours.
+func RadiansToHours(r float64) float64 {
+	return r * 12 / math.Pi
+}
+
+// GAST returns the Greenwich apparent sidereal time in radians in the range
+// [0, 2π), the mean sidereal time of GMST corrected by the equation of the
+// equinoxes. The arguments are as for GMST.
+func GAST(ut1, tt Date, model SiderealModel) float64 {
+	return normRadians(GMST(ut1, tt, model) + EquationOfEquinoxes(tt))
+}
+
+// EquationOfEquinoxes returns the difference between apparent and mean
+// sidereal time in radians at the TT julian date tt. The nutation comes from
+// the truncated series of Meeus, Astronomical Algorithms chapter 22, which is
+// good to about 0.5" in longitude, or 0.03 seconds of time.
+func EquationOfEquinoxes(tt Date) float64 {
+	const deg = math.Pi / 180
+	t := tt.Century()
+	omega := poly(t, 125.04452, -1934.136261, 0.0020708, 1.0/450000) * deg
+	l := (280.4665 + 36000.7698*t) * deg   // mean longitude of the Sun
+	lm := (218.3165 + 481267.8813*t) * deg // mean longitude of the Moon
+	dpsi := -17.20*math.Sin(omega) - 1.32*math.Sin(2*l) - 0.23*math.Sin(2*lm) + 0.21*math.Sin(2*omega)
+	deps := 9.20*math.Cos(omega) + 0.57*math.Cos(2*l) + 0.10*math.Cos(2*lm) - 0.09*math.Cos(2*omega)
+	eps := poly(t, 84381.448, -46.8150, -0.00059, 0.001813) + deps
+	// the complementary terms of the IAU 1994 definition
+	ee := dpsi*math.Cos(eps*arcsec_to_rad) + 0.00264*math.Sin(omega) + 0.000063*math.Sin(2*omega)
+	return ee * arcsec_to_rad
+}
+
+// LST returns the local mean sidereal time in radians in the range [0, 2π)
+// at the given longitude, in degrees east of Greenwich. The julian date is
+// taken as UT1, or as UTC where UT1 is not known to better than a second;
+// the IAU 2006 expression of GMST is used.
+func LST(ut1 Date, longitudeDeg float64) float64 {
+	return normRadians(GMST(ut1, ut1.TT(), IAU2006) + longitudeDeg*math.Pi/180)
+}
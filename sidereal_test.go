@@ -0,0 +1,144 @@
+package julian
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestSiderealDays(t *testing.T) {
+	tests := []struct {
+		name  string
+		solar float64
+		want  float64
+	}{
+		{"zero", 0, 0},
+		{"one day", 1, 1.002737909350795},
+		{"one year", 365.25, 366.2500},
+		{"negative", -2, -2.00547581870159},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := SiderealDays(tt.solar)
+			if math.Abs(got-tt.want) > 1e-4 {
+				t.Errorf("SiderealDays(%v) = %v, want %v", tt.solar, got, tt.want)
+			}
+			if back := SolarDays(got); math.Abs(back-tt.solar) > 1e-12 {
+				t.Errorf("SolarDays(%v) = %v, want %v", got, back, tt.solar)
+			}
+		})
+	}
+}
+
+func TestSiderealDay(t *testing.T) {
+	got := time.Duration(SolarDays(1) * day_nanoseconds)
+	if d := got - SiderealDay; d < -time.Millisecond || d > time.Millisecond {
+		t.Errorf("SolarDays(1) = %v, want %v", got, SiderealDay)
+	}
+}
+
+func TestERA(t *testing.T) {
+	tests := []struct {
+		name string
+		ut1  Date
+		want float64
+	}{
+		{"J2000", Date(2_451_545), 4.894961212823756},
+		{"SOFA", Date(2_400_000.5 + 54_388), 0.4022837240028158},
+		{"negative", Date(-1_000.25), normRadians(2 * math.Pi * (0.75 + 0.7790572732640 + 0.00273781191135448*(-1_000.25-2_451_545)))},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ERA(tt.ut1)
+			if math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("ERA() = %.15f, want %.15f", got, tt.want)
+			}
+			if got < 0 || got >= 2*math.Pi {
+				t.Errorf("ERA() = %v, out of range", got)
+			}
+		})
+	}
+}
+
+func TestGMST(t *testing.T) {
+	jd := Date(2_400_000.5 + 53_736)
+	tests := []struct {
+		name  string
+		model SiderealModel
+		want  float64
+	}{
+		// the SOFA test values for iauGmst06 and iauGmst82
+		{"IAU 2006", IAU2006, 1.754174971870091203},
+		{"IAU 1982", IAU1982, 1.754174981860675096},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GMST(jd, jd, tt.model); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("GMST() = %.15f, want %.15f", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGMST_range(t *testing.T) {
+	for jd := Date(2_451_545); jd < 2_451_546; jd += 0.01 {
+		for _, m := range []SiderealModel{IAU2006, IAU1982} {
+			if g := GMST(jd, jd, m); g < 0 || g >= 2*math.Pi {
+				t.Fatalf("GMST(%v, %v) = %v, out of range", jd, m, g)
+			}
+		}
+	}
+	// J2000 is 18h41m50.5s of mean sidereal time
+	if h := RadiansToHours(GMST(2_451_545, 2_451_545, IAU1982)); math.Abs(h-18.697374558) > 1e-6 {
+		t.Errorf("RadiansToHours(GMST(J2000)) = %v, want 18.697374558", h)
+	}
+}
+
+func TestSiderealModel_String(t *testing.T) {
+	if got := IAU1982.String(); got != "IAU 1982" {
+		t.Errorf("IAU1982.String() = %q", got)
+	}
+}
+
+func TestGAST(t *testing.T) {
+	// Meeus, Astronomical Algorithms, example 12.b: 1987 April 10 at 0h UT
+	ut := Date(2_446_895.5)
+	tt := ut + 55.0/day_seconds
+	const sec = 2 * math.Pi / day_seconds // radians per second of time
+	if got, want := EquationOfEquinoxes(tt)/sec, -0.2317; math.Abs(got-want) > 0.005 {
+		t.Errorf("EquationOfEquinoxes() = %.4fs, want %.4fs", got, want)
+	}
+	want := (13*3600 + 10*60 + 46.1351) * sec
+	if got := GAST(ut, tt, IAU1982); math.Abs(got-want) > 0.01*sec {
+		t.Errorf("GAST() = %.4fs, want %.4fs", got/sec, want/sec)
+	}
+	if got := GAST(ut, tt, IAU2006); math.Abs(got-want) > 0.01*sec {
+		t.Errorf("GAST(IAU2006) = %.4fs, want %.4fs", got/sec, want/sec)
+	}
+}
+
+func TestLST(t *testing.T) {
+	jd := Date(2_451_545)
+	gmst := GMST(jd, jd.TT(), IAU2006)
+	tests := []struct {
+		name string
+		lon  float64
+		want float64
+	}{
+		{"Greenwich", 0, gmst},
+		{"east", 90, gmst + math.Pi/2},
+		{"west", -118.25, gmst - 118.25*math.Pi/180 + 2*math.Pi},
+		{"full turn", 360, gmst},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := LST(jd, tt.lon)
+			if d := math.Abs(got - normRadians(tt.want)); d > 1e-12 && d < 2*math.Pi-1e-12 {
+				t.Errorf("LST(%v) = %v, want %v", tt.lon, got, normRadians(tt.want))
+			}
+			if got < 0 || got >= 2*math.Pi {
+				t.Errorf("LST(%v) = %v, out of range", tt.lon, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package julian
+
+import "sort"
+
+// smear_half is half the length of the leap smear window in seconds.
+const smear_half = day_seconds / 2
+
+// smearWindow returns the leap second whose 24-hour smear window, noon to
+// noon UTC around the leap, contains the UTC julian date jd: the Unix second
+// at which the window starts and the change in TAI-UTC, +1 for an inserted
+// second. It returns ok false outside any window.
+func smearWindow(jd Date) (start, leap int64, ok bool) {
+	entries := currentLeapTable().Entries
+	i := sort.Search(len(entries), func(i int) bool { return entries[i].Start > jd+0.5 })
+	if i < 2 {
+		return 0, 0, false
+	}
+	e, prev := entries[i-1], entries[i-2]
+	if jd < e.Start-0.5 || e.DriftRate != 0 || prev.DriftRate != 0 {
+		return 0, 0, false
+	}
+	start = int64(e.Start-julian_unix)*day_seconds - smear_half
+	return start, int64(e.Offset - prev.Offset), true
+}
+
+// FromUnixSmeared returns the julian date corresponding to the Unix time sec
+// seconds and nsec nanoseconds read from a clock that smears leap seconds,
+// as the public NTP services of Google and Amazon do. Such a clock absorbs
+// each leap second by running slow (or fast) by 1/86400 over the 24 hours
+// from noon to noon UTC around it, so it never shows 23:59:60. Outside
+// those windows FromUnixSmeared is the same as FromUnix.
+//
+// A time that falls within an inserted leap second is folded onto the
+// midnight that follows it.
+func FromUnixSmeared(sec int64, nsec int64) Date {
+	start, leap, ok := smearWindow(FromUnix(sec, nsec))
+	if !ok {
+		return FromUnix(sec, nsec)
+	}
+	x := (sec-start)*1e9 + nsec
+	if x < 0 || x >= day_nanoseconds {
+		return FromUnix(sec, nsec)
+	}
+	// elapsed SI nanoseconds since the window started
+	e := x * (day_seconds + leap) / day_seconds
+	const mid = smear_half * 1e9
+	if e >= mid+min(0, leap*1e9) {
+		e = max(e-leap*1e9, mid)
+	}
+	return FromUnix(start, e)
+}
+
+// UnixNanoSmeared is the inverse of FromUnixSmeared. It returns the julian
+// date as the Unix time in nanoseconds shown by a leap smearing clock.
+func (jd Date) UnixNanoSmeared() int64 {
+	n := jd.UnixNano()
+	start, leap, ok := smearWindow(jd)
+	if !ok {
+		return n
+	}
+	r := n - start*1e9
+	if r < 0 || r >= day_nanoseconds {
+		return n
+	}
+	if r >= smear_half*1e9 {
+		r += leap * 1e9
+	}
+	return start*1e9 + r*day_seconds/(day_seconds+leap)
+}
+
+// UnixSmeared is like UnixNanoSmeared but returns whole seconds, rounded
+// down.
+func (jd Date) UnixSmeared() int64 {
+	return floorDiv(jd.UnixNanoSmeared(), 1e9)
+}
@@ -0,0 +1,58 @@
+package julian
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFromUnixSmeared(t *testing.T) {
+	const leap = 1_483_228_800 // 2017-01-01, after the last leap second
+	tests := []struct {
+		name      string
+		sec, nsec int64
+		want      time.Time
+	}{
+		{"before window", leap - 43_201, 0, time.Unix(leap-43_201, 0)},
+		{"window start", leap - 43_200, 0, time.Unix(leap-43_200, 0)},
+		{"quarter", leap - 21_600, 0, time.Unix(leap-21_600, 250_000_000)},
+		{"leap", leap, 0, time.Unix(leap, 0)},
+		{"three quarters", leap + 21_600, 0, time.Unix(leap+21_599, 750_000_000)},
+		{"window end", leap + 43_200, 0, time.Unix(leap+43_200, 0)},
+		{"no leap", 1_262_304_000, 0, time.Unix(1_262_304_000, 0)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FromUnixSmeared(tt.sec, tt.nsec)
+			if !timeEquals(got.Gregorian(), tt.want) {
+				t.Errorf("FromUnixSmeared(%v, %v) = %v, want %v", tt.sec, tt.nsec, got.UTC(), tt.want.UTC())
+			}
+			if tt.name == "leap" {
+				return
+			}
+			back := got.UnixNanoSmeared()
+			if d := back - (tt.sec*1e9 + tt.nsec); d < -50_000 || d > 50_000 {
+				t.Errorf("UnixNanoSmeared() = %v, want %v", back, tt.sec*1e9+tt.nsec)
+			}
+		})
+	}
+}
+
+func TestFromUnixSmeared_negative(t *testing.T) {
+	SetLeapSeconds(&LeapTable{Entries: []LeapSecond{
+		{Start: utc_1972, Offset: 10},
+		{Start: 2_457_754.5, Offset: 9},
+	}})
+	defer SetLeapSeconds(nil)
+
+	const leap = 1_483_228_800
+	got := FromUnixSmeared(leap, 0)
+	if want := time.Unix(leap, 500_000_000); !timeEquals(got.Gregorian(), want) {
+		t.Errorf("FromUnixSmeared(%v, 0) = %v, want %v", leap, got.UTC(), want.UTC())
+	}
+	if back := got.UnixNanoSmeared(); back < leap*1e9-50_000 || back > leap*1e9+50_000 {
+		t.Errorf("UnixNanoSmeared() = %v, want %v", back, leap*1e9)
+	}
+	if back := Date(2_457_755).UnixSmeared(); back != leap+43_200 {
+		t.Errorf("UnixSmeared() = %v, want %v", back, leap+43_200)
+	}
+}
@@ -0,0 +1,29 @@
+package julian
+
+import "slices"
+
+// CompareDates returns an integer comparing two julian dates, for use with
+// the slices and sort packages. The result is -1 if a is before b, +1 if a is
+// after b, and 0 if they are equal. NaN values sort before all other values.
+func CompareDates(a, b Date) int {
+	return a.Compare(b)
+}
+
+// SortDates sorts a slice of julian dates in increasing order, with NaN
+// values first.
+func SortDates(dates []Date) {
+	slices.SortFunc(dates, CompareDates)
+}
+
+// IsSortedDates reports whether dates is sorted in increasing order, with NaN
+// values first.
+func IsSortedDates(dates []Date) bool {
+	return slices.IsSortedFunc(dates, CompareDates)
+}
+
+// SearchDates searches for jd in the sorted slice dates and returns the index
+// of the first date not before jd and whether jd itself was found. The slice
+// must be sorted in increasing order, as by SortDates.
+func SearchDates(dates []Date, jd Date) (int, bool) {
+	return slices.BinarySearchFunc(dates, jd, CompareDates)
+}
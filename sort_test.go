@@ -0,0 +1,50 @@
+package julian
+
+import (
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestSortDates(t *testing.T) {
+	nan := Date(math.NaN())
+	dates := []Date{2_451_546, nan, 2_451_545.5, 0, -1, 2_451_545.5}
+	if IsSortedDates(dates) {
+		t.Errorf("IsSortedDates(%v) = true before sorting", dates)
+	}
+	SortDates(dates)
+	if !IsSortedDates(dates) {
+		t.Errorf("IsSortedDates(%v) = false after sorting", dates)
+	}
+	if !math.IsNaN(float64(dates[0])) {
+		t.Errorf("SortDates() = %v, want NaN first", dates)
+	}
+	want := []Date{-1, 0, 2_451_545.5, 2_451_545.5, 2_451_546}
+	if !slices.Equal(dates[1:], want) {
+		t.Errorf("SortDates() = %v, want %v", dates[1:], want)
+	}
+}
+
+func TestSearchDates(t *testing.T) {
+	dates := []Date{-1, 0, 2_451_545.5, 2_451_546}
+	tests := []struct {
+		name  string
+		jd    Date
+		index int
+		found bool
+	}{
+		{"first", -1, 0, true},
+		{"middle", 2_451_545.5, 2, true},
+		{"missing", 2_451_545, 2, false},
+		{"after", 2_451_547, 4, false},
+		{"before", -2, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			index, found := SearchDates(dates, tt.jd)
+			if index != tt.index || found != tt.found {
+				t.Errorf("SearchDates() = %v, %v, want %v, %v", index, found, tt.index, tt.found)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package julian
+
+import (
+	"math"
+	"sort"
+)
+
+// UnixNanoTAI returns the UTC julian date as a TAI Unix time, the number of
+// SI nanoseconds elapsed since January 1, 1970 TAI, which unlike UnixNano
+// counts every leap second.
+//
+// The result is undefined if it cannot be represented by an int64, as for
+// UnixNano.
+func (jd Date) UnixNanoTAI() int64 {
+	return jd.UnixNano() + int64(math.Round(TAIMinusUTCAt(jd)*1e9))
+}
+
+// UnixTAI is like UnixNanoTAI but returns whole seconds, rounded down.
+func (jd Date) UnixTAI() int64 {
+	return floorDiv(jd.UnixNanoTAI(), 1e9)
+}
+
+// FromUnixTAI returns the UTC julian date corresponding to the TAI Unix time
+// sec seconds and nsec nanoseconds since January 1, 1970 TAI. It is the
+// inverse of UnixNanoTAI. A time within an inserted leap second, which has
+// no UTC julian date, is folded onto the midnight that follows it; use
+// FromUnixTAIStrict to detect it.
+func FromUnixTAI(sec int64, nsec int64) Date {
+	jd, _ := FromUnixTAIStrict(sec, nsec)
+	return jd
+}
+
+// FromUnixTAIStrict is like FromUnixTAI but also reports whether the time
+// falls within an inserted leap second, 23:59:60 UTC. The returned date is
+// then the midnight that follows the leap second.
+func FromUnixTAIStrict(sec int64, nsec int64) (jd Date, leap bool) {
+	sec += floorDiv(nsec, 1e9)
+	nsec = floorMod(nsec, 1e9)
+	entries := currentLeapTable().Entries
+	n := sec*1e9 + nsec
+	// the last entry whose start, counted in TAI, is not after n
+	i := sort.Search(len(entries), func(i int) bool { return entryTAINano(entries[i]) > n }) - 1
+	if i < 0 || entries[i].DriftRate != 0 {
+		return TAIToUTC(FromUnix(sec, nsec)), false
+	}
+	utc := n - int64(math.Round(entries[i].Offset))*1e9
+	if i+1 < len(entries) && utc >= entryNano(entries[i+1]) {
+		return entries[i+1].Start, true
+	}
+	return FromUnix(0, utc), false
+}
+
+// entryNano returns the Unix time in nanoseconds at which e takes effect.
+func entryNano(e LeapSecond) int64 {
+	return int64(math.Round(float64(e.Start-julian_unix))) * day_nanoseconds
+}
+
+// entryTAINano returns the TAI Unix time in nanoseconds at which e takes
+// effect.
+func entryTAINano(e LeapSecond) int64 {
+	return entryNano(e) + int64(math.Round(e.Offset*1e9))
+}
@@ -0,0 +1,50 @@
+package julian
+
+import "testing"
+
+func TestUnixTAI(t *testing.T) {
+	tests := []struct {
+		name string
+		jd   Date
+		want int64
+	}{
+		{"J2000", Date(2_451_545), 946_728_000 + 32},
+		{"2017", Date(2_457_754.5), 1_483_228_800 + 37},
+		{"1970", Date(julian_unix), 8},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.jd.UnixTAI(); got != tt.want {
+				t.Errorf("Date(%v).UnixTAI() = %v, want %v", tt.jd, got, tt.want)
+			}
+			got := FromUnixTAI(tt.jd.UnixTAI(), tt.jd.UnixNanoTAI()%1e9)
+			if !equalJulian(got, tt.jd) {
+				t.Errorf("FromUnixTAI(%v) = %v, want %v", tt.jd.UnixTAI(), got, tt.jd)
+			}
+		})
+	}
+}
+
+func TestFromUnixTAIStrict(t *testing.T) {
+	const leap = 1_483_228_800 // 2017-01-01 UTC, after 2016-12-31T23:59:60
+	tests := []struct {
+		name      string
+		sec, nsec int64
+		want      Date
+		leap      bool
+	}{
+		{"before", leap + 35, 500_000_000, FromUnix(leap-1, 500_000_000), false},
+		{"23:59:60", leap + 36, 0, 2_457_754.5, true},
+		{"23:59:60.999999999", leap + 36, 999_999_999, 2_457_754.5, true},
+		{"midnight", leap + 37, 0, 2_457_754.5, false},
+		{"after", leap + 37, 1e9 + 250_000_000, FromUnix(leap+1, 250_000_000), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, leap := FromUnixTAIStrict(tt.sec, tt.nsec)
+			if got != tt.want || leap != tt.leap {
+				t.Errorf("FromUnixTAIStrict(%v, %v) = %v, %v, want %v, %v", tt.sec, tt.nsec, got, leap, tt.want, tt.leap)
+			}
+		})
+	}
+}